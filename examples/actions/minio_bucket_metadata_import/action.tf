@@ -0,0 +1,21 @@
+data "minio_bucket_metadata_export" "source" {
+  bucket = "source-bucket"
+}
+
+action "minio_bucket_metadata_import" "copy" {
+  config {
+    bucket   = minio_s3_bucket.target.bucket
+    metadata = data.minio_bucket_metadata_export.source.metadata
+  }
+}
+
+resource "minio_s3_bucket" "target" {
+  bucket = "target-bucket"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.minio_bucket_metadata_import.copy]
+    }
+  }
+}
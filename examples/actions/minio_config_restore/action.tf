@@ -0,0 +1,11 @@
+data "minio_config_history" "recent" {
+  limit = 5
+}
+
+action "minio_config_restore" "previous" {
+  config {
+    restore_id = data.minio_config_history.recent.entries[1].restore_id
+  }
+}
+
+# terraform apply -invoke=action.minio_config_restore.previous
@@ -0,0 +1,9 @@
+action "minio_incomplete_upload_cleanup" "uploads" {
+  config {
+    bucket = minio_s3_bucket.data.bucket
+    prefix = "uploads/"
+  }
+}
+
+# Run the cleanup on demand:
+#   terraform apply -invoke=action.minio_incomplete_upload_cleanup.uploads
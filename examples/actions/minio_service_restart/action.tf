@@ -0,0 +1,18 @@
+action "minio_service_restart" "this" {
+  config {
+    timeout = "5m"
+  }
+}
+
+# Restart the servers whenever the region setting changes.
+resource "minio_config" "region" {
+  key   = "region"
+  value = "name=eu-west-1"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.minio_service_restart.this]
+    }
+  }
+}
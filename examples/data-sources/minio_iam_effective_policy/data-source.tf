@@ -0,0 +1,17 @@
+# Effective policy of a user, including policies inherited from groups
+data "minio_iam_effective_policy" "alice" {
+  user = "alice"
+}
+
+output "alice_policy" {
+  value = data.minio_iam_effective_policy.alice.policy
+}
+
+output "alice_writable_buckets" {
+  value = [for b in data.minio_iam_effective_policy.alice.bucket_access : b.bucket if b.write]
+}
+
+# Effective access of a service account, restricted by its session policy
+data "minio_iam_effective_policy" "ci" {
+  service_account = "ci-access-key"
+}
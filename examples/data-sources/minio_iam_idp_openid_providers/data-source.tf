@@ -0,0 +1,8 @@
+data "minio_iam_idp_openid_providers" "all" {}
+
+output "openid_role_arns" {
+  value = {
+    for p in data.minio_iam_idp_openid_providers.all.providers : p.name => p.role_arn
+    if p.role_arn != ""
+  }
+}
@@ -0,0 +1,7 @@
+data "minio_iam_ldap_access_keys" "app" {
+  user_dn = "uid=app,ou=people,dc=example,dc=org"
+}
+
+output "app_access_keys" {
+  value = data.minio_iam_ldap_access_keys.app.access_keys[*].access_key
+}
@@ -0,0 +1,15 @@
+data "minio_iam_ldap_policy_entities" "all" {}
+
+# Policies mapped to LDAP principals on the server
+output "ldap_policy_mappings" {
+  value = {
+    for m in data.minio_iam_ldap_policy_entities.all.policy_mappings : m.policy => {
+      users  = m.users
+      groups = m.groups
+    }
+  }
+}
+
+data "minio_iam_ldap_policy_entities" "readwrite" {
+  policies = ["readwrite"]
+}
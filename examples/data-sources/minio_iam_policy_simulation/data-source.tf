@@ -0,0 +1,43 @@
+data "minio_iam_policy_document" "reports" {
+  statement {
+    sid       = "ReadReports"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::reports/*"]
+  }
+}
+
+data "minio_iam_policy_simulation" "reports" {
+  policy_documents = [data.minio_iam_policy_document.reports.json]
+  actions          = ["s3:GetObject", "s3:DeleteObject"]
+  resources        = ["arn:aws:s3:::reports/2024/q1.csv"]
+}
+
+resource "minio_iam_policy" "reports" {
+  name   = "reports-read"
+  policy = data.minio_iam_policy_document.reports.json
+
+  lifecycle {
+    precondition {
+      condition     = !data.minio_iam_policy_simulation.reports.results[1].allowed
+      error_message = "The reports policy must not allow deleting reports."
+    }
+  }
+}
+
+# Verify the effective access of an existing user on every plan
+check "alice_cannot_write_audit" {
+  data "minio_iam_policy_simulation" "alice" {
+    user      = "alice"
+    actions   = ["s3:PutObject"]
+    resources = ["arn:aws:s3:::audit/*"]
+
+    context {
+      source_ip = "10.0.0.12"
+    }
+  }
+
+  assert {
+    condition     = !data.minio_iam_policy_simulation.alice.all_allowed
+    error_message = "alice is able to write to the audit bucket."
+  }
+}
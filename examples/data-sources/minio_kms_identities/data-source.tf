@@ -0,0 +1,5 @@
+data "minio_kms_identities" "all" {}
+
+output "kms_identity_policies" {
+  value = { for i in data.minio_kms_identities.all.identities : i.identity => i.policy }
+}
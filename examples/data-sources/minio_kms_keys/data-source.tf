@@ -0,0 +1,7 @@
+data "minio_kms_keys" "app" {
+  pattern = "app-*"
+}
+
+output "app_key_ids" {
+  value = data.minio_kms_keys.app.key_ids
+}
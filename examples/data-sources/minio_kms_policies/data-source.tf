@@ -0,0 +1,5 @@
+data "minio_kms_policies" "all" {}
+
+output "kms_policy_names" {
+  value = data.minio_kms_policies.all.policies[*].name
+}
@@ -0,0 +1,14 @@
+# Public download link for a release artifact
+data "minio_s3_presigned_url" "installer" {
+  bucket     = minio_s3_object.installer.bucket_name
+  key        = minio_s3_object.installer.object_name
+  expires_in = 86400
+
+  response_headers = {
+    "content-disposition" = "attachment; filename=\"installer.sh\""
+  }
+}
+
+output "installer_url" {
+  value = data.minio_s3_presigned_url.installer.url
+}
@@ -0,0 +1,16 @@
+# Upload link for CI, never written to state
+ephemeral "minio_s3_presigned_url" "upload" {
+  bucket       = "artifacts"
+  key          = "builds/app.tar.gz"
+  method       = "PUT"
+  expires_in   = 900
+  content_type = "application/gzip"
+}
+
+# Browser upload form restricted to a prefix and a maximum size
+ephemeral "minio_s3_presigned_url" "form" {
+  bucket             = "uploads"
+  key_prefix         = "incoming/"
+  method             = "POST"
+  content_length_max = 10485760
+}
@@ -0,0 +1,26 @@
+# Short-lived, read-only credentials for a single bucket
+ephemeral "minio_sts_credentials" "reports" {
+  duration_seconds = 900
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Resource = ["arn:aws:s3:::reports", "arn:aws:s3:::reports/*"]
+    }]
+  })
+}
+
+# Hand the credentials to a Kubernetes secret without storing them in state
+resource "kubernetes_secret_v1" "reports" {
+  metadata {
+    name = "reports-s3"
+  }
+
+  data_wo = {
+    AWS_ACCESS_KEY_ID     = ephemeral.minio_sts_credentials.reports.access_key
+    AWS_SECRET_ACCESS_KEY = ephemeral.minio_sts_credentials.reports.secret_key
+    AWS_SESSION_TOKEN     = ephemeral.minio_sts_credentials.reports.session_token
+  }
+  data_wo_revision = 1
+}
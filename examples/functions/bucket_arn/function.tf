@@ -0,0 +1,14 @@
+resource "minio_iam_policy" "reports_read" {
+  name = "reports-read"
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = ["s3:GetObject", "s3:ListBucket"]
+      Resource = [
+        provider::minio::bucket_arn(minio_s3_bucket.reports.bucket),
+        "${provider::minio::bucket_arn(minio_s3_bucket.reports.bucket)}/*",
+      ]
+    }]
+  })
+}
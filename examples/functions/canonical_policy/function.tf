@@ -0,0 +1,3 @@
+output "canonical" {
+  value = provider::minio::canonical_policy(file("${path.module}/policy.json"))
+}
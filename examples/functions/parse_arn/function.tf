@@ -0,0 +1,4 @@
+# "dest"
+output "replication_target_bucket" {
+  value = provider::minio::parse_arn("arn:minio:replication:us-east-1:c5be6b16-6b1d-4de8-9d3b-f4b4f6bd7c61:dest").resource
+}
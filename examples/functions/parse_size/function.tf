@@ -0,0 +1,4 @@
+resource "minio_s3_bucket_quota" "reports" {
+  bucket = minio_s3_bucket.reports.bucket
+  quota  = provider::minio::parse_size("10GiB")
+}
@@ -0,0 +1,6 @@
+check "reports_policy_in_sync" {
+  assert {
+    condition     = provider::minio::policies_equivalent(minio_iam_policy.reports_read.policy, file("${path.module}/reports-read.json"))
+    error_message = "The reports-read policy differs from reports-read.json."
+  }
+}
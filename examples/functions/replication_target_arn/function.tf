@@ -0,0 +1,3 @@
+output "replication_target_arn" {
+  value = provider::minio::replication_target_arn("us-east-1", var.target_id, "reports-replica")
+}
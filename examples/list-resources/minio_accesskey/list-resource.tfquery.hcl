@@ -0,0 +1,3 @@
+list "minio_accesskey" "all" {
+  provider = minio
+}
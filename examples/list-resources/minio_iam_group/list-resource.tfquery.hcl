@@ -0,0 +1,3 @@
+list "minio_iam_group" "all" {
+  provider = minio
+}
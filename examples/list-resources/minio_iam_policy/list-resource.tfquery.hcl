@@ -0,0 +1,3 @@
+list "minio_iam_policy" "all" {
+  provider = minio
+}
@@ -0,0 +1,7 @@
+list "minio_iam_service_account" "ci" {
+  provider = minio
+
+  config {
+    user = "ci"
+  }
+}
@@ -0,0 +1,7 @@
+list "minio_iam_user" "disabled" {
+  provider = minio
+
+  config {
+    status = "disabled"
+  }
+}
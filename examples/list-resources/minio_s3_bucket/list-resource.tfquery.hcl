@@ -0,0 +1,7 @@
+list "minio_s3_bucket" "team" {
+  provider = minio
+
+  config {
+    name_prefix = "team-"
+  }
+}
@@ -10,3 +10,47 @@ jobs:
       expire-days: 30
 EOF
 }
+
+resource "minio_batch_job" "expire_typed" {
+  expire {
+    bucket = minio_s3_bucket.logs.bucket
+    prefix = "tmp/"
+
+    rule {
+      type            = "object"
+      older_than      = "720h"
+      retain_versions = 1
+
+      tags {
+        key   = "lifecycle"
+        value = "ephemeral*"
+      }
+    }
+
+    retry {
+      attempts = 5
+      delay    = "1s"
+    }
+  }
+}
+
+resource "minio_batch_job" "replicate_typed" {
+  replicate {
+    source {
+      bucket = "source-bucket"
+      prefix = "data/"
+    }
+
+    target {
+      bucket                = "target-bucket"
+      endpoint              = "https://remote-minio.example.com:9000"
+      access_key            = "replication-user"
+      secret_key_wo         = var.remote_secret_key
+      secret_key_wo_version = 1
+    }
+
+    filter {
+      newer_than = "168h"
+    }
+  }
+}
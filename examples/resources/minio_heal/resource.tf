@@ -0,0 +1,18 @@
+resource "minio_heal" "after_drive_replacement" {
+  bucket          = "critical-data"
+  prefix          = "invoices/"
+  scan_mode       = "deep"
+  remove_dangling = true
+
+  triggers = {
+    replaced_drive = "node3-disk2"
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
+
+output "heal_failures" {
+  value = minio_heal.after_drive_replacement.items_failed
+}
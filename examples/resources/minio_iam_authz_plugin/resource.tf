@@ -0,0 +1,4 @@
+resource "minio_iam_authz_plugin" "opa" {
+  url        = "http://opa:8181/v1/data/httpapi/authz/allow"
+  auth_token = "Bearer ${var.opa_token}"
+}
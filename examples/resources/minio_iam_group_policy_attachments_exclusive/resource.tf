@@ -0,0 +1,14 @@
+resource "minio_iam_group" "developers" {
+  name = "developers"
+}
+
+resource "minio_iam_group_policy_attachments_exclusive" "developers" {
+  group_name   = minio_iam_group.developers.name
+  policy_names = ["readwrite"]
+}
+
+# LDAP groups are identified by their DN
+resource "minio_iam_group_policy_attachments_exclusive" "ldap_admins" {
+  group_name   = "cn=admins,ou=groups,dc=example,dc=org"
+  policy_names = ["consoleAdmin"]
+}
@@ -0,0 +1,7 @@
+resource "minio_iam_idp_plugin" "custom" {
+  url                   = "https://auth.example.com/minio/authenticate"
+  auth_token_wo         = "Bearer ${var.identity_plugin_token}"
+  auth_token_wo_version = 1
+  role_policy           = "readonly"
+  role_id               = "custom-auth"
+}
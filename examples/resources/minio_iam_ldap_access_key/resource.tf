@@ -0,0 +1,29 @@
+# Access key inheriting the policies of the LDAP user
+resource "minio_iam_ldap_access_key" "app" {
+  user_dn     = "uid=app,ou=people,dc=example,dc=org"
+  name        = "app"
+  description = "Access key for the app deployment"
+  expiration  = "2027-01-01T00:00:00Z"
+}
+
+ephemeral "vault_kv_secret_v2" "backup_secret" {
+  mount = "secret"
+  name  = "minio/ldap/backup"
+}
+
+# Access key restricted to an inline policy, with a write-only secret
+resource "minio_iam_ldap_access_key" "backup" {
+  user_dn               = "uid=backup,ou=people,dc=example,dc=org"
+  access_key            = "backupagent"
+  secret_key_wo         = tostring(ephemeral.vault_kv_secret_v2.backup_secret.data.secret_key)
+  secret_key_wo_version = 1
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:PutObject"]
+      Resource = ["arn:aws:s3:::backups/*"]
+    }]
+  })
+}
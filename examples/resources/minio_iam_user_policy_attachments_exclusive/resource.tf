@@ -0,0 +1,14 @@
+resource "minio_iam_user" "app" {
+  name = "app"
+}
+
+resource "minio_iam_user_policy_attachments_exclusive" "app" {
+  user_name    = minio_iam_user.app.name
+  policy_names = ["readonly", minio_iam_policy.app.name]
+}
+
+# LDAP users are identified by their DN
+resource "minio_iam_user_policy_attachments_exclusive" "ldap_user" {
+  user_name    = "uid=dillon,ou=people,dc=example,dc=org"
+  policy_names = ["readwrite"]
+}
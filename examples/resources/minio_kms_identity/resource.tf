@@ -0,0 +1,4 @@
+resource "minio_kms_identity" "app" {
+  identity = "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22"
+  policy   = minio_kms_policy.app.name
+}
@@ -2,3 +2,10 @@
 resource "minio_kms_key" "example" {
   key_id = "my-encryption-key"
 }
+
+# Import existing key material (bring your own key)
+resource "minio_kms_key" "imported" {
+  key_id                  = "my-imported-key"
+  key_material_wo         = var.key_material_base64
+  key_material_wo_version = 1
+}
@@ -0,0 +1,11 @@
+resource "minio_kms_policy" "app" {
+  name = "app"
+  allow = [
+    "/v1/key/create/app-*",
+    "/v1/key/generate/app-*",
+    "/v1/key/decrypt/app-*",
+  ]
+  deny = [
+    "/v1/key/delete/*",
+  ]
+}
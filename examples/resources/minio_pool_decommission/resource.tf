@@ -1,3 +1,9 @@
 resource "minio_pool_decommission" "example" {
   pool_index = 1
+
+  wait_for_completion = true
+
+  timeouts {
+    create = "6h"
+  }
 }
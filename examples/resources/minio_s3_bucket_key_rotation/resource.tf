@@ -0,0 +1,25 @@
+resource "minio_kms_key" "current" {
+  key_id = "data-2026"
+}
+
+resource "minio_s3_bucket_server_side_encryption" "data" {
+  bucket          = minio_s3_bucket.data.id
+  encryption_type = "aws:kms"
+  kms_key_id      = "data-2025"
+
+  # The rotation resource takes over the bucket's default key.
+  lifecycle {
+    ignore_changes = [kms_key_id]
+  }
+}
+
+resource "minio_s3_bucket_key_rotation" "data" {
+  bucket     = minio_s3_bucket.data.id
+  kms_key_id = minio_kms_key.current.key_id
+  prefix     = "archive/"
+  older_than = "30d"
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,6 @@
+resource "minio_server_config_browser" "main" {
+  csp_policy              = "default-src 'self' 'unsafe-eval' 'unsafe-inline';"
+  hsts_seconds            = "31536000"
+  hsts_include_subdomains = true
+  referrer_policy         = "strict-origin-when-cross-origin"
+}
@@ -0,0 +1,4 @@
+resource "minio_server_config_callhome" "main" {
+  enable    = true
+  frequency = "24h"
+}
@@ -0,0 +1,5 @@
+resource "minio_server_config_compression" "main" {
+  enable     = true
+  extensions = [".txt", ".log", ".csv", ".json"]
+  mime_types = ["text/*", "application/json", "application/xml"]
+}
@@ -0,0 +1,3 @@
+resource "minio_server_config_drive" "main" {
+  max_timeout = "30s"
+}
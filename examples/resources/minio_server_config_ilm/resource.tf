@@ -0,0 +1,4 @@
+resource "minio_server_config_ilm" "main" {
+  transition_workers = "100"
+  expiration_workers = "100"
+}
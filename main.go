@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
 	"github.com/aminueza/terraform-provider-minio/v3/minio"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 func main() {
@@ -13,9 +15,20 @@ func main() {
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: minio.Provider,
-		Debug:        debugMode,
-		ProviderAddr: "registry.terraform.io/aminueza/minio",
-	})
+	ctx := context.Background()
+
+	serverFactory, err := minio.ProtoV6ProviderServerFactory(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	err = tf6server.Serve("registry.terraform.io/aminueza/minio", serverFactory, serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
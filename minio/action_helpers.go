@@ -0,0 +1,42 @@
+package minio
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// actionConfigured reports an error when the provider has not been
+// configured before the action is invoked.
+func actionConfigured(client *S3MinioClient, diags *diag.Diagnostics) bool {
+	if client != nil {
+		return true
+	}
+	diags.AddError("Provider not configured", "The MinIO provider must be configured before invoking actions.")
+	return false
+}
+
+// sendProgress reports progress to Terraform while an action runs. The
+// framework leaves SendProgress unset in some code paths, e.g. unit tests.
+func sendProgress(resp *action.InvokeResponse, format string, args ...any) {
+	if resp.SendProgress == nil {
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf(format, args...)})
+}
+
+// frameworkDiagnostics converts diagnostics built by helpers shared with the
+// SDKv2 resources.
+func frameworkDiagnostics(sdkDiags sdkdiag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, d := range sdkDiags {
+		if d.Severity == sdkdiag.Error {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,87 @@
+package minio
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.ActionWithConfigure = &actionMinioBucketMetadataImport{}
+
+type actionMinioBucketMetadataImport struct {
+	client *S3MinioClient
+}
+
+type bucketMetadataImportModel struct {
+	Bucket   types.String `tfsdk:"bucket"`
+	Metadata types.String `tfsdk:"metadata"`
+}
+
+func newActionMinioBucketMetadataImport() action.Action {
+	return &actionMinioBucketMetadataImport{}
+}
+
+func (a *actionMinioBucketMetadataImport) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket_metadata_import"
+}
+
+func (a *actionMinioBucketMetadataImport) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports a base64-encoded zip stream of bucket metadata, as produced by minio_bucket_metadata_export, into a bucket.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the bucket to import the metadata into.",
+			},
+			"metadata": schema.StringAttribute{
+				Required:    true,
+				Description: "Base64-encoded zip stream of bucket metadata.",
+			},
+		},
+	}
+}
+
+func (a *actionMinioBucketMetadataImport) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (a *actionMinioBucketMetadataImport) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !actionConfigured(a.client, &resp.Diagnostics) {
+		return
+	}
+
+	var data bucketMetadataImportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	bucket := data.Bucket.ValueString()
+
+	decoded, err := base64.StdEncoding.DecodeString(data.Metadata.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid bucket metadata", fmt.Sprintf("metadata is not valid base64: %s", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing metadata for bucket: %s", bucket))
+
+	result, err := a.client.S3Admin.ImportBucketMetadata(ctx, bucket, io.NopCloser(bytes.NewReader(decoded)))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error importing metadata for bucket %s", bucket), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(frameworkDiagnostics(checkBucketMetaImportErrs(bucket, result))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sendProgress(resp, "Imported metadata into bucket %s", bucket)
+}
@@ -0,0 +1,68 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.ActionWithConfigure = &actionMinioConfigRestore{}
+
+type actionMinioConfigRestore struct {
+	client *S3MinioClient
+}
+
+type configRestoreModel struct {
+	RestoreID types.String `tfsdk:"restore_id"`
+}
+
+func newActionMinioConfigRestore() action.Action {
+	return &actionMinioConfigRestore{}
+}
+
+func (a *actionMinioConfigRestore) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_restore"
+}
+
+func (a *actionMinioConfigRestore) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores the MinIO server configuration from a config history entry. " +
+			"Use the minio_config_history data source to find restore IDs.",
+		Attributes: map[string]schema.Attribute{
+			"restore_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Config history restore ID to restore.",
+			},
+		},
+	}
+}
+
+func (a *actionMinioConfigRestore) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (a *actionMinioConfigRestore) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !actionConfigured(a.client, &resp.Diagnostics) {
+		return
+	}
+
+	var data configRestoreModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	restoreID := data.RestoreID.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Restoring config from history ID: %s", restoreID))
+
+	if err := a.client.S3Admin.RestoreConfigHistoryKV(ctx, restoreID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error restoring config %s", restoreID), err.Error())
+		return
+	}
+
+	sendProgress(resp, "Restored config %s. Some settings only take effect after a restart (minio_service_restart).", restoreID)
+}
@@ -0,0 +1,78 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.ActionWithConfigure = &actionMinioIncompleteUploadCleanup{}
+
+type actionMinioIncompleteUploadCleanup struct {
+	client *S3MinioClient
+}
+
+type incompleteUploadCleanupModel struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Prefix types.String `tfsdk:"prefix"`
+}
+
+func newActionMinioIncompleteUploadCleanup() action.Action {
+	return &actionMinioIncompleteUploadCleanup{}
+}
+
+func (a *actionMinioIncompleteUploadCleanup) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incomplete_upload_cleanup"
+}
+
+func (a *actionMinioIncompleteUploadCleanup) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Aborts the incomplete multipart uploads of a bucket, optionally restricted to a prefix.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the bucket to clean up.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only abort uploads of objects under this prefix. Defaults to all objects.",
+			},
+		},
+	}
+}
+
+func (a *actionMinioIncompleteUploadCleanup) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (a *actionMinioIncompleteUploadCleanup) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !actionConfigured(a.client, &resp.Diagnostics) {
+		return
+	}
+
+	var data incompleteUploadCleanupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := &S3MinioIncompleteUploadCleanup{
+		MinioClient: a.client.S3Client,
+		MinioBucket: data.Bucket.ValueString(),
+		MinioPrefix: data.Prefix.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Cleaning up incomplete uploads in %s/%s", config.MinioBucket, config.MinioPrefix))
+
+	count, err := cleanupIncompleteUploads(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error cleaning up incomplete uploads in bucket %s", config.MinioBucket), err.Error())
+		return
+	}
+
+	sendProgress(resp, "Aborted %d incomplete uploads in bucket %s", count, config.MinioBucket)
+}
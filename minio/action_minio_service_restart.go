@@ -0,0 +1,152 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/minio/madmin-go/v4"
+)
+
+const serviceRestartDefaultTimeout = 10 * time.Minute
+
+// serviceRestartPollInterval is how often the servers are probed while
+// waiting for them to come back.
+var serviceRestartPollInterval = 2 * time.Second
+
+var (
+	_ action.ActionWithConfigure      = &actionMinioServiceRestart{}
+	_ action.ActionWithValidateConfig = &actionMinioServiceRestart{}
+)
+
+type actionMinioServiceRestart struct {
+	client *S3MinioClient
+}
+
+type serviceRestartModel struct {
+	WaitForReady types.Bool   `tfsdk:"wait_for_ready"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+// timeout returns the configured timeout, or the default when unset.
+func (m serviceRestartModel) timeout() (time.Duration, error) {
+	if m.Timeout.IsNull() || m.Timeout.IsUnknown() {
+		return serviceRestartDefaultTimeout, nil
+	}
+	timeout, err := time.ParseDuration(m.Timeout.ValueString())
+	if err != nil {
+		return 0, err
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be positive, got %s", timeout)
+	}
+	return timeout, nil
+}
+
+func newActionMinioServiceRestart() action.Action {
+	return &actionMinioServiceRestart{}
+}
+
+func (a *actionMinioServiceRestart) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_restart"
+}
+
+func (a *actionMinioServiceRestart) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts all the servers of the MinIO deployment, then waits for them to serve requests again.",
+		Attributes: map[string]schema.Attribute{
+			"wait_for_ready": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait for the servers to answer admin requests after the restart. Defaults to true.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the restart, as a Go duration (e.g. `5m`). Defaults to `10m`.",
+			},
+		},
+	}
+}
+
+func (a *actionMinioServiceRestart) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (a *actionMinioServiceRestart) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data serviceRestartModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := data.timeout(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid timeout", err.Error())
+	}
+}
+
+func (a *actionMinioServiceRestart) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !actionConfigured(a.client, &resp.Diagnostics) {
+		return
+	}
+
+	var data serviceRestartModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, err := data.timeout()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid timeout", err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Restarting MinIO servers")
+	sendProgress(resp, "Restarting MinIO servers")
+
+	if err := a.client.S3Admin.ServiceRestart(ctx); err != nil {
+		resp.Diagnostics.AddError("Error restarting MinIO servers", err.Error())
+		return
+	}
+
+	if data.WaitForReady.IsNull() || data.WaitForReady.ValueBool() {
+		sendProgress(resp, "Waiting for MinIO servers to come back")
+		if err := waitForServersReady(ctx, a.client.S3Admin); err != nil {
+			resp.Diagnostics.AddError("Error waiting for MinIO servers after restart", err.Error())
+			return
+		}
+	}
+
+	sendProgress(resp, "MinIO servers restarted")
+}
+
+// waitForServersReady polls the servers until they answer a server info
+// request. The first probe is delayed so that it does not reach servers that
+// have not gone down yet.
+func waitForServersReady(ctx context.Context, admin *madmin.AdminClient) error {
+	ticker := time.NewTicker(serviceRestartPollInterval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for {
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return fmt.Errorf("%w (last error: %s)", ctx.Err(), lastErr)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		if _, lastErr = admin.ServerInfo(ctx); lastErr == nil {
+			return nil
+		}
+		tflog.Debug(ctx, fmt.Sprintf("MinIO servers not ready yet: %s", lastErr))
+	}
+}
@@ -0,0 +1,118 @@
+package minio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestActions_schemas(t *testing.T) {
+	ctx := context.Background()
+	p := NewFrameworkProvider(Provider()).(*frameworkProvider)
+	want := map[string]bool{
+		"minio_service_restart":           true,
+		"minio_incomplete_upload_cleanup": true,
+		"minio_bucket_metadata_import":    true,
+		"minio_config_restore":            true,
+	}
+
+	for _, newAction := range p.Actions(ctx) {
+		a := newAction()
+
+		var metadata action.MetadataResponse
+		a.Metadata(ctx, action.MetadataRequest{ProviderTypeName: "minio"}, &metadata)
+		if !want[metadata.TypeName] {
+			t.Errorf("unexpected action %s", metadata.TypeName)
+		}
+		delete(want, metadata.TypeName)
+
+		var schema action.SchemaResponse
+		a.Schema(ctx, action.SchemaRequest{}, &schema)
+		if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("%s: invalid schema: %v", metadata.TypeName, diags)
+		}
+	}
+
+	for typeName := range want {
+		t.Errorf("action %s is not registered", typeName)
+	}
+}
+
+func TestActions_notConfigured(t *testing.T) {
+	ctx := context.Background()
+	p := NewFrameworkProvider(Provider()).(*frameworkProvider)
+
+	for _, newAction := range p.Actions(ctx) {
+		a := newAction()
+
+		var schema action.SchemaResponse
+		a.Schema(ctx, action.SchemaRequest{}, &schema)
+		config := tfsdk.Config{
+			Schema: schema.Schema,
+			Raw:    tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil),
+		}
+
+		var resp action.InvokeResponse
+		a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Provider not configured" {
+			t.Errorf("invoking %T without a client: %v", a, resp.Diagnostics)
+		}
+	}
+}
+
+func TestServiceRestartModelTimeout(t *testing.T) {
+	ctx := context.Background()
+	a := newActionMinioServiceRestart().(*actionMinioServiceRestart)
+
+	var schema action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schema)
+	objectType := schema.Schema.Type().TerraformType(ctx)
+
+	cases := map[string]struct {
+		timeout tftypes.Value
+		valid   bool
+	}{
+		"default":  {tftypes.NewValue(tftypes.String, nil), true},
+		"duration": {tftypes.NewValue(tftypes.String, "90s"), true},
+		"invalid":  {tftypes.NewValue(tftypes.String, "soon"), false},
+		"negative": {tftypes.NewValue(tftypes.String, "-1m"), false},
+	}
+	for name, c := range cases {
+		config := tfsdk.Config{
+			Schema: schema.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"wait_for_ready": tftypes.NewValue(tftypes.Bool, nil),
+				"timeout":        c.timeout,
+			}),
+		}
+
+		var resp action.ValidateConfigResponse
+		a.ValidateConfig(ctx, action.ValidateConfigRequest{Config: config}, &resp)
+		if resp.Diagnostics.HasError() == c.valid {
+			t.Errorf("%s: ValidateConfig diagnostics %v, want valid=%t", name, resp.Diagnostics, c.valid)
+		}
+	}
+
+	var data serviceRestartModel
+	if timeout, err := data.timeout(); err != nil || timeout != serviceRestartDefaultTimeout {
+		t.Errorf("default timeout = %s, %v, want %s", timeout, err, serviceRestartDefaultTimeout)
+	}
+}
+
+func TestFrameworkDiagnostics(t *testing.T) {
+	diags := frameworkDiagnostics(sdkdiag.Diagnostics{
+		{Severity: sdkdiag.Error, Summary: "importing bucket metadata", Detail: "access denied"},
+		{Severity: sdkdiag.Warning, Summary: "partial import", Detail: "versioning: failed"},
+	})
+
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 1 {
+		t.Fatalf("converted diagnostics %v, want one error and one warning", diags)
+	}
+	if diags.Errors()[0].Detail() != "access denied" || diags.Warnings()[0].Summary() != "partial import" {
+		t.Errorf("converted diagnostics %v lost their summary or detail", diags)
+	}
+}
@@ -0,0 +1,829 @@
+package minio
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"go.yaml.in/yaml/v3"
+)
+
+// batchJobTypedBlocks lists the typed job blocks in the order they are checked.
+// Each block name doubles as the batch job type it renders.
+var batchJobTypedBlocks = []string{"replicate", "expire", "keyrotate"}
+
+const batchJobDefaultAPIVersion = "v1"
+
+type batchJobKV struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+}
+
+type batchJobFilter struct {
+	NewerThan     string       `yaml:"newerThan,omitempty"`
+	OlderThan     string       `yaml:"olderThan,omitempty"`
+	CreatedAfter  string       `yaml:"createdAfter,omitempty"`
+	CreatedBefore string       `yaml:"createdBefore,omitempty"`
+	Tags          []batchJobKV `yaml:"tags,omitempty"`
+	Metadata      []batchJobKV `yaml:"metadata,omitempty"`
+	KMSKey        string       `yaml:"kmskey,omitempty"`
+}
+
+type batchJobNotify struct {
+	Endpoint string `yaml:"endpoint,omitempty"`
+	Token    string `yaml:"token,omitempty"`
+}
+
+type batchJobRetry struct {
+	Attempts int    `yaml:"attempts,omitempty"`
+	Delay    string `yaml:"delay,omitempty"`
+}
+
+type batchJobFlags struct {
+	Filter *batchJobFilter `yaml:"filter,omitempty"`
+	Notify *batchJobNotify `yaml:"notify,omitempty"`
+	Retry  *batchJobRetry  `yaml:"retry,omitempty"`
+}
+
+type batchJobCredentials struct {
+	AccessKey    string `yaml:"accessKey"`
+	SecretKey    string `yaml:"secretKey"`
+	SessionToken string `yaml:"sessionToken,omitempty"`
+}
+
+type batchJobReplicateLocation struct {
+	Type        string               `yaml:"type,omitempty"`
+	Bucket      string               `yaml:"bucket"`
+	Prefix      string               `yaml:"prefix,omitempty"`
+	Endpoint    string               `yaml:"endpoint,omitempty"`
+	Path        string               `yaml:"path,omitempty"`
+	Credentials *batchJobCredentials `yaml:"credentials,omitempty"`
+}
+
+type batchJobReplicateSpec struct {
+	APIVersion string                    `yaml:"apiVersion"`
+	Source     batchJobReplicateLocation `yaml:"source"`
+	Target     batchJobReplicateLocation `yaml:"target"`
+	Flags      *batchJobFlags            `yaml:"flags,omitempty"`
+}
+
+type batchJobExpireSize struct {
+	LessThan    string `yaml:"lessThan,omitempty"`
+	GreaterThan string `yaml:"greaterThan,omitempty"`
+}
+
+type batchJobExpirePurge struct {
+	RetainVersions int `yaml:"retainVersions"`
+}
+
+type batchJobExpireRule struct {
+	Type          string               `yaml:"type"`
+	Name          string               `yaml:"name,omitempty"`
+	OlderThan     string               `yaml:"olderThan,omitempty"`
+	CreatedBefore string               `yaml:"createdBefore,omitempty"`
+	Tags          []batchJobKV         `yaml:"tags,omitempty"`
+	Metadata      []batchJobKV         `yaml:"metadata,omitempty"`
+	Size          *batchJobExpireSize  `yaml:"size,omitempty"`
+	Purge         *batchJobExpirePurge `yaml:"purge,omitempty"`
+}
+
+type batchJobExpireSpec struct {
+	APIVersion string               `yaml:"apiVersion"`
+	Bucket     string               `yaml:"bucket"`
+	Prefix     string               `yaml:"prefix,omitempty"`
+	Rules      []batchJobExpireRule `yaml:"rules"`
+	Notify     *batchJobNotify      `yaml:"notify,omitempty"`
+	Retry      *batchJobRetry       `yaml:"retry,omitempty"`
+}
+
+type batchJobKeyRotateEncryption struct {
+	Type    string `yaml:"type"`
+	Key     string `yaml:"key,omitempty"`
+	Context string `yaml:"context,omitempty"`
+}
+
+type batchJobKeyRotateSpec struct {
+	APIVersion string                      `yaml:"apiVersion"`
+	Bucket     string                      `yaml:"bucket"`
+	Prefix     string                      `yaml:"prefix,omitempty"`
+	Encryption batchJobKeyRotateEncryption `yaml:"encryption"`
+	Flags      *batchJobFlags              `yaml:"flags,omitempty"`
+}
+
+// batchJobSecrets carries write-only credentials read from the raw config.
+// They are only available during apply and are left empty when rendering at plan time.
+type batchJobSecrets struct {
+	SourceSecretKey string
+	TargetSecretKey string
+}
+
+func batchJobKVSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Key to match.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Value to match. Supports `*` wildcards.",
+				},
+			},
+		},
+	}
+}
+
+func batchJobFilterSchema(withKMSKey bool) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"newer_than": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Match objects newer than this age (e.g. `7d10h31s`).",
+		},
+		"older_than": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Match objects older than this age (e.g. `7d10h31s`).",
+		},
+		"created_after": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "Match objects created after this RFC3339 timestamp.",
+		},
+		"created_before": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "Match objects created before this RFC3339 timestamp.",
+		},
+		"tags":     batchJobKVSchema("Match objects carrying these tags."),
+		"metadata": batchJobKVSchema("Match objects carrying this metadata."),
+	}
+	if withKMSKey {
+		s["kms_key"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Match only objects encrypted with this KMS key ID (SSE-KMS only).",
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Filter criteria applied to source objects.",
+		Elem:        &schema.Resource{Schema: s},
+	}
+}
+
+func batchJobNotifySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Webhook notified with job status events.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"endpoint": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "Notification endpoint URL.",
+				},
+				"token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Authentication token sent to the notification endpoint (e.g. `Bearer xxxxx`).",
+				},
+			},
+		},
+	}
+}
+
+func batchJobRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Retry behaviour for the job. Each retry skips objects already processed.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Number of retries before giving up.",
+				},
+				"delay": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Minimum delay between retries as a Go duration (e.g. `500ms`).",
+				},
+			},
+		},
+	}
+}
+
+func batchJobReplicateLocationSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "minio",
+					ValidateFunc: validation.StringInSlice([]string{"minio", "s3"}, false),
+					Description:  "Endpoint type: `minio` or `s3`. Defaults to `minio`.",
+				},
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 63),
+					Description:  "Bucket name.",
+				},
+				"prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Object prefix.",
+				},
+				"endpoint": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "Remote endpoint URL. Omit for the local deployment.",
+				},
+				"path": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"on", "off", "auto"}, false),
+					Description:  "Bucket lookup style: `on` (path-style), `off` (virtual-host style) or `auto`.",
+				},
+				"access_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Access key for the remote endpoint.",
+				},
+				"secret_key_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Sensitive:   true,
+					Description: "Write-only secret key for the remote endpoint. Never stored in state.",
+				},
+				"secret_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Version identifier for `secret_key_wo`. Change this value to resubmit the job with a new secret.",
+				},
+			},
+		},
+	}
+}
+
+func batchJobReplicateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: append([]string{"job_yaml"}, batchJobTypedBlocks...),
+		Description:  "Typed definition of a `replicate` job. Rendered to the server's YAML format by the provider.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"source": batchJobReplicateLocationSchema("Source of the objects to replicate."),
+				"target": batchJobReplicateLocationSchema("Target the objects are replicated to."),
+				"filter": batchJobFilterSchema(false),
+				"notify": batchJobNotifySchema(),
+				"retry":  batchJobRetrySchema(),
+			},
+		},
+	}
+}
+
+func batchJobExpireSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: append([]string{"job_yaml"}, batchJobTypedBlocks...),
+		Description:  "Typed definition of an `expire` job. Rendered to the server's YAML format by the provider.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 63),
+					Description:  "Bucket to expire objects from.",
+				},
+				"prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Only expire objects under this prefix.",
+				},
+				"rule": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: "Expiration rules. An object is expired when it matches any rule.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{"object", "deleted"}, false),
+								Description:  "`object` matches objects with zero or more older versions, `deleted` matches objects whose latest version is a delete marker.",
+							},
+							"name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Match object names against this wildcard expression.",
+							},
+							"older_than": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Match objects older than this age (e.g. `70h`).",
+							},
+							"created_before": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.IsRFC3339Time,
+								Description:  "Match objects created before this RFC3339 timestamp.",
+							},
+							"tags":     batchJobKVSchema("Match objects carrying these tags. Only valid for `object` rules."),
+							"metadata": batchJobKVSchema("Match objects carrying this metadata. Only valid for `object` rules."),
+							"size_less_than": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Match objects smaller than this size (e.g. `10MiB`). Only valid for `object` rules.",
+							},
+							"size_greater_than": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Match objects larger than this size (e.g. `1MiB`). Only valid for `object` rules.",
+							},
+							"retain_versions": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "Number of most recent versions to keep. `0` (default) deletes all versions.",
+							},
+						},
+					},
+				},
+				"notify": batchJobNotifySchema(),
+				"retry":  batchJobRetrySchema(),
+			},
+		},
+	}
+}
+
+func batchJobKeyRotateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: append([]string{"job_yaml"}, batchJobTypedBlocks...),
+		Description:  "Typed definition of a `keyrotate` job. Rendered to the server's YAML format by the provider.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 63),
+					Description:  "Bucket whose objects are re-encrypted.",
+				},
+				"prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Only rotate objects under this prefix.",
+				},
+				"encryption_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"sse-s3", "sse-kms"}, false),
+					Description:  "Target encryption: `sse-s3` or `sse-kms`.",
+				},
+				"kms_key_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "KMS key ID objects are re-encrypted with. Only valid with `sse-kms`.",
+				},
+				"kms_context": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "KMS encryption context. Only valid with `sse-kms`.",
+				},
+				"filter": batchJobFilterSchema(true),
+				"notify": batchJobNotifySchema(),
+				"retry":  batchJobRetrySchema(),
+			},
+		},
+	}
+}
+
+// typedBatchJobType returns the job type of the typed block present in the
+// configuration, or "" when the job is defined through job_yaml.
+func typedBatchJobType(get func(string) interface{}) string {
+	for _, block := range batchJobTypedBlocks {
+		if l, ok := get(block).([]interface{}); ok && len(l) > 0 && l[0] != nil {
+			return block
+		}
+	}
+	return ""
+}
+
+// renderBatchJobYAML converts a typed job block into the YAML document accepted
+// by StartBatchJob.
+func renderBatchJobYAML(jobType string, block map[string]interface{}, apiVersion string, secrets batchJobSecrets) (string, error) {
+	if apiVersion == "" {
+		apiVersion = batchJobDefaultAPIVersion
+	}
+
+	var spec interface{}
+	switch jobType {
+	case "replicate":
+		s := batchJobReplicateSpec{
+			APIVersion: apiVersion,
+			Source:     expandBatchJobReplicateLocation(block["source"], secrets.SourceSecretKey),
+			Target:     expandBatchJobReplicateLocation(block["target"], secrets.TargetSecretKey),
+			Flags:      expandBatchJobFlags(block),
+		}
+		spec = s
+	case "expire":
+		s := batchJobExpireSpec{
+			APIVersion: apiVersion,
+			Bucket:     block["bucket"].(string),
+			Prefix:     block["prefix"].(string),
+			Notify:     expandBatchJobNotify(block["notify"]),
+			Retry:      expandBatchJobRetry(block["retry"]),
+		}
+		for _, r := range block["rule"].([]interface{}) {
+			if r == nil {
+				continue
+			}
+			rule, err := expandBatchJobExpireRule(r.(map[string]interface{}))
+			if err != nil {
+				return "", err
+			}
+			s.Rules = append(s.Rules, rule)
+		}
+		spec = s
+	case "keyrotate":
+		s := batchJobKeyRotateSpec{
+			APIVersion: apiVersion,
+			Bucket:     block["bucket"].(string),
+			Prefix:     block["prefix"].(string),
+			Encryption: batchJobKeyRotateEncryption{
+				Type:    block["encryption_type"].(string),
+				Key:     block["kms_key_id"].(string),
+				Context: block["kms_context"].(string),
+			},
+			Flags: expandBatchJobFlags(block),
+		}
+		if s.Encryption.Type != "sse-kms" && (s.Encryption.Key != "" || s.Encryption.Context != "") {
+			return "", fmt.Errorf("keyrotate: kms_key_id and kms_context are only valid with encryption_type \"sse-kms\"")
+		}
+		spec = s
+	default:
+		return "", fmt.Errorf("unsupported batch job type %q", jobType)
+	}
+
+	out, err := yaml.Marshal(map[string]interface{}{jobType: spec})
+	if err != nil {
+		return "", fmt.Errorf("rendering %s job: %w", jobType, err)
+	}
+	return string(out), nil
+}
+
+// batchJobSecretsFromConfig reads the write-only credentials of a replicate block.
+func batchJobSecretsFromConfig(d *schema.ResourceData) (batchJobSecrets, error) {
+	var secrets batchJobSecrets
+
+	for _, side := range []string{"source", "target"} {
+		path := cty.GetAttrPath("replicate").IndexInt(0).GetAttr(side).IndexInt(0).GetAttr("secret_key_wo")
+		secret, _, err := getWriteOnlyStringAt(d, path, fmt.Sprintf("replicate.%s.secret_key_wo", side))
+		if err != nil {
+			return secrets, err
+		}
+		if side == "source" {
+			secrets.SourceSecretKey = secret
+		} else {
+			secrets.TargetSecretKey = secret
+		}
+	}
+
+	return secrets, nil
+}
+
+// batchJobCommentedKeyPattern matches template lines that document an optional
+// key by commenting it out, e.g. "    # path: on|off|auto".
+var batchJobCommentedKeyPattern = regexp.MustCompile(`^(\s+)#\s*([A-Za-z][A-Za-z0-9]*:(?:\s.*)?)$`)
+
+// batchJobTemplateShape holds the keys a template allows below a node. A nil
+// shape stands for a scalar or empty value, whose contents are not checked.
+type batchJobTemplateShape map[string]batchJobTemplateShape
+
+// validateBatchJobAgainstTemplate checks that every key of the rendered job,
+// at any depth, appears in the server's template for the job type. Keys the
+// template only documents in comments count as supported. The template's
+// apiVersion is read separately by batchJobTemplateAPIVersion.
+func validateBatchJobAgainstTemplate(jobType, rendered, template string) error {
+	shape, err := batchJobTemplateSectionShape(template, jobType)
+	if err != nil {
+		return fmt.Errorf("parsing %s template: %w", jobType, err)
+	}
+	renderedSection, err := batchJobSection(rendered, jobType)
+	if err != nil {
+		return fmt.Errorf("parsing rendered %s job: %w", jobType, err)
+	}
+
+	seen := map[string]bool{}
+	collectUnknownBatchJobKeys("", renderedSection, shape, seen)
+	if len(seen) > 0 {
+		unknown := make([]string, 0, len(seen))
+		for key := range seen {
+			unknown = append(unknown, key)
+		}
+		sort.Strings(unknown)
+		return fmt.Errorf("%s job uses keys not supported by this server: %s", jobType, strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// batchJobTemplateSectionShape parses the job type's section of a template,
+// with commented-out keys restored. The template is parsed as is when the
+// restored document is not valid YAML.
+func batchJobTemplateSectionShape(template, jobType string) (batchJobTemplateShape, error) {
+	lines := strings.Split(template, "\n")
+	for i, line := range lines {
+		lines[i] = batchJobCommentedKeyPattern.ReplaceAllString(line, "$1$2")
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &doc); err != nil {
+		if err := yaml.Unmarshal([]byte(template), &doc); err != nil {
+			return nil, err
+		}
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("document has no top-level %q section", jobType)
+	}
+
+	root := doc.Content[0]
+	if root.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			if root.Content[i].Value == jobType && root.Content[i+1].Kind == yaml.MappingNode {
+				return batchJobNodeShape(root.Content[i+1]), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("document has no top-level %q section", jobType)
+}
+
+// batchJobNodeShape returns the keys allowed below a template node: the keys
+// of a mapping, or the union of the element keys of a sequence.
+func batchJobNodeShape(n *yaml.Node) batchJobTemplateShape {
+	switch n.Kind {
+	case yaml.MappingNode:
+		if len(n.Content) == 0 {
+			return nil
+		}
+		shape := batchJobTemplateShape{}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := n.Content[i].Value
+			child := batchJobNodeShape(n.Content[i+1])
+			if existing, ok := shape[key]; ok {
+				child = mergeBatchJobTemplateShapes(existing, child)
+			}
+			shape[key] = child
+		}
+		return shape
+	case yaml.SequenceNode:
+		var shape batchJobTemplateShape
+		for i, elem := range n.Content {
+			if i == 0 {
+				shape = batchJobNodeShape(elem)
+				continue
+			}
+			shape = mergeBatchJobTemplateShapes(shape, batchJobNodeShape(elem))
+		}
+		return shape
+	}
+	return nil
+}
+
+func mergeBatchJobTemplateShapes(a, b batchJobTemplateShape) batchJobTemplateShape {
+	if a == nil || b == nil {
+		return nil
+	}
+	merged := batchJobTemplateShape{}
+	for key, child := range a {
+		merged[key] = child
+	}
+	for key, child := range b {
+		if existing, ok := merged[key]; ok {
+			child = mergeBatchJobTemplateShapes(existing, child)
+		}
+		merged[key] = child
+	}
+	return merged
+}
+
+// collectUnknownBatchJobKeys records the dotted path of every key in value
+// that shape does not allow.
+func collectUnknownBatchJobKeys(path string, value interface{}, shape batchJobTemplateShape, unknown map[string]bool) {
+	if shape == nil {
+		return
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			childShape, ok := shape[key]
+			if !ok {
+				unknown[childPath] = true
+				continue
+			}
+			collectUnknownBatchJobKeys(childPath, child, childShape, unknown)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			collectUnknownBatchJobKeys(path, elem, shape, unknown)
+		}
+	}
+}
+
+// batchJobTemplateAPIVersion extracts the apiVersion advertised by a job template.
+func batchJobTemplateAPIVersion(template, jobType string) string {
+	section, err := batchJobSection(template, jobType)
+	if err != nil {
+		return batchJobDefaultAPIVersion
+	}
+	if v, ok := section["apiVersion"].(string); ok && v != "" {
+		return v
+	}
+	return batchJobDefaultAPIVersion
+}
+
+func batchJobSection(doc, jobType string) (map[string]interface{}, error) {
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(doc), &parsed); err != nil {
+		return nil, err
+	}
+	section, ok := parsed[jobType].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("document has no top-level %q section", jobType)
+	}
+	return section, nil
+}
+
+func expandBatchJobReplicateLocation(v interface{}, secretKey string) batchJobReplicateLocation {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return batchJobReplicateLocation{}
+	}
+	m := l[0].(map[string]interface{})
+
+	loc := batchJobReplicateLocation{
+		Type:     m["type"].(string),
+		Bucket:   m["bucket"].(string),
+		Prefix:   m["prefix"].(string),
+		Endpoint: m["endpoint"].(string),
+		Path:     m["path"].(string),
+	}
+	if accessKey := m["access_key"].(string); accessKey != "" || secretKey != "" {
+		loc.Credentials = &batchJobCredentials{
+			AccessKey: accessKey,
+			SecretKey: secretKey,
+		}
+	}
+
+	return loc
+}
+
+func expandBatchJobFlags(block map[string]interface{}) *batchJobFlags {
+	flags := &batchJobFlags{
+		Filter: expandBatchJobFilter(block["filter"]),
+		Notify: expandBatchJobNotify(block["notify"]),
+		Retry:  expandBatchJobRetry(block["retry"]),
+	}
+	if flags.Filter == nil && flags.Notify == nil && flags.Retry == nil {
+		return nil
+	}
+	return flags
+}
+
+func expandBatchJobFilter(v interface{}) *batchJobFilter {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	f := &batchJobFilter{
+		NewerThan:     m["newer_than"].(string),
+		OlderThan:     m["older_than"].(string),
+		CreatedAfter:  m["created_after"].(string),
+		CreatedBefore: m["created_before"].(string),
+		Tags:          expandBatchJobKVs(m["tags"]),
+		Metadata:      expandBatchJobKVs(m["metadata"]),
+	}
+	if kmsKey, ok := m["kms_key"].(string); ok {
+		f.KMSKey = kmsKey
+	}
+
+	return f
+}
+
+func expandBatchJobNotify(v interface{}) *batchJobNotify {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	return &batchJobNotify{
+		Endpoint: m["endpoint"].(string),
+		Token:    m["token"].(string),
+	}
+}
+
+func expandBatchJobRetry(v interface{}) *batchJobRetry {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	return &batchJobRetry{
+		Attempts: m["attempts"].(int),
+		Delay:    m["delay"].(string),
+	}
+}
+
+func expandBatchJobKVs(v interface{}) []batchJobKV {
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var kvs []batchJobKV
+	for _, item := range l {
+		if item == nil {
+			continue
+		}
+		m := item.(map[string]interface{})
+		kvs = append(kvs, batchJobKV{
+			Key:   m["key"].(string),
+			Value: m["value"].(string),
+		})
+	}
+	return kvs
+}
+
+func expandBatchJobExpireRule(m map[string]interface{}) (batchJobExpireRule, error) {
+	rule := batchJobExpireRule{
+		Type:          m["type"].(string),
+		Name:          m["name"].(string),
+		OlderThan:     m["older_than"].(string),
+		CreatedBefore: m["created_before"].(string),
+		Tags:          expandBatchJobKVs(m["tags"]),
+		Metadata:      expandBatchJobKVs(m["metadata"]),
+	}
+
+	lessThan := m["size_less_than"].(string)
+	greaterThan := m["size_greater_than"].(string)
+	if lessThan != "" || greaterThan != "" {
+		rule.Size = &batchJobExpireSize{LessThan: lessThan, GreaterThan: greaterThan}
+	}
+
+	if retain := m["retain_versions"].(int); retain > 0 {
+		rule.Purge = &batchJobExpirePurge{RetainVersions: retain}
+	}
+
+	if rule.Type == "deleted" && (len(rule.Tags) > 0 || len(rule.Metadata) > 0 || rule.Size != nil) {
+		return rule, fmt.Errorf("expire: tags, metadata and size filters are only valid for rules of type \"object\"")
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,293 @@
+package minio
+
+import (
+	"strings"
+	"testing"
+)
+
+const testExpireTemplate = `expire:
+  apiVersion: v1
+  bucket: mybucket # Bucket where this job will expire matching objects from
+  prefix: myprefix # (Optional) Prefix under which this job will expire objects matching the rules below.
+  rules:
+    - type: object  # objects with zero ore more older versions
+      name: NAME # match object names that satisfy the wildcard expression.
+      olderThan: 70h # match objects older than this value
+      createdBefore: "2006-01-02T15:04:05.00Z" # match objects created before "date"
+      tags:
+        - key: name
+          value: pick* # match objects with tag 'name', all values starting with 'pick'
+      size:
+        lessThan: "10MiB" # match objects with size less than this value (e.g. 10MiB)
+        greaterThan: 1MiB # match objects with size greater than this value (e.g. 1MiB)
+      purge:
+          # retainVersions: 0 # (default) delete all versions of the object. This option is the fastest.
+          # retainVersions: 5 # keep the latest 5 versions of the object.
+
+    - type: deleted # objects with delete marker as their latest version
+      name: NAME
+      olderThan: 10h
+      purge:
+          # retainVersions: 0 # (default) delete all versions of the object. This option is the fastest.
+          # retainVersions: 5 # keep the latest 5 versions of the object.
+
+  notify:
+    endpoint: https://notify.endpoint # notification endpoint to receive job completion status
+    token: Bearer xxxxx # optional authentication token for the notification endpoint
+  retry:
+    attempts: 10
+    delay: 500ms
+`
+
+func TestTypedBatchJobType(t *testing.T) {
+	values := map[string]interface{}{
+		"replicate": []interface{}{},
+		"expire":    []interface{}{map[string]interface{}{"bucket": "b"}},
+		"keyrotate": []interface{}{},
+	}
+	if got := typedBatchJobType(func(k string) interface{} { return values[k] }); got != "expire" {
+		t.Errorf("typedBatchJobType() = %q, want %q", got, "expire")
+	}
+
+	values["expire"] = []interface{}{}
+	if got := typedBatchJobType(func(k string) interface{} { return values[k] }); got != "" {
+		t.Errorf("typedBatchJobType() = %q, want empty", got)
+	}
+}
+
+func TestRenderBatchJobYAML_expire(t *testing.T) {
+	block := map[string]interface{}{
+		"bucket": "logs",
+		"prefix": "tmp/",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"type":              "object",
+				"name":              "*.log",
+				"older_than":        "72h",
+				"created_before":    "",
+				"tags":              []interface{}{map[string]interface{}{"key": "env", "value": "dev*"}},
+				"metadata":          []interface{}{},
+				"size_less_than":    "10MiB",
+				"size_greater_than": "",
+				"retain_versions":   2,
+			},
+		},
+		"notify": []interface{}{map[string]interface{}{"endpoint": "https://hooks.example.com", "token": "Bearer abc"}},
+		"retry":  []interface{}{},
+	}
+
+	rendered, err := renderBatchJobYAML("expire", block, "", batchJobSecrets{})
+	if err != nil {
+		t.Fatalf("renderBatchJobYAML() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"expire:",
+		"apiVersion: v1",
+		"bucket: logs",
+		"prefix: tmp/",
+		"olderThan: 72h",
+		"lessThan: 10MiB",
+		"retainVersions: 2",
+		"key: env",
+		"endpoint: https://hooks.example.com",
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("rendered job missing %q:\n%s", want, rendered)
+		}
+	}
+	for _, unwanted := range []string{"greaterThan", "createdBefore", "retry:"} {
+		if strings.Contains(rendered, unwanted) {
+			t.Errorf("rendered job unexpectedly contains %q:\n%s", unwanted, rendered)
+		}
+	}
+
+	if err := validateBatchJobAgainstTemplate("expire", rendered, testExpireTemplate); err != nil {
+		t.Errorf("validateBatchJobAgainstTemplate() error = %v", err)
+	}
+}
+
+func TestRenderBatchJobYAML_expireDeletedRuleRejectsTags(t *testing.T) {
+	block := map[string]interface{}{
+		"bucket": "logs",
+		"prefix": "",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"type":              "deleted",
+				"name":              "",
+				"older_than":        "",
+				"created_before":    "",
+				"tags":              []interface{}{map[string]interface{}{"key": "env", "value": "dev"}},
+				"metadata":          []interface{}{},
+				"size_less_than":    "",
+				"size_greater_than": "",
+				"retain_versions":   0,
+			},
+		},
+		"notify": []interface{}{},
+		"retry":  []interface{}{},
+	}
+
+	if _, err := renderBatchJobYAML("expire", block, "", batchJobSecrets{}); err == nil {
+		t.Fatal("expected error for tags on a deleted rule")
+	}
+}
+
+func TestRenderBatchJobYAML_replicateCredentials(t *testing.T) {
+	location := func(bucket, endpoint, accessKey string) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"type":                  "minio",
+			"bucket":                bucket,
+			"prefix":                "",
+			"endpoint":              endpoint,
+			"path":                  "",
+			"access_key":            accessKey,
+			"secret_key_wo_version": 0,
+		}}
+	}
+	block := map[string]interface{}{
+		"source": location("src", "", ""),
+		"target": location("dst", "https://remote:9000", "remote-user"),
+		"filter": []interface{}{},
+		"notify": []interface{}{},
+		"retry":  []interface{}{map[string]interface{}{"attempts": 3, "delay": "1s"}},
+	}
+
+	rendered, err := renderBatchJobYAML("replicate", block, "v2", batchJobSecrets{TargetSecretKey: "s3cret"})
+	if err != nil {
+		t.Fatalf("renderBatchJobYAML() error = %v", err)
+	}
+
+	section, err := batchJobSection(rendered, "replicate")
+	if err != nil {
+		t.Fatalf("batchJobSection() error = %v", err)
+	}
+	if section["apiVersion"] != "v2" {
+		t.Errorf("apiVersion = %v, want v2", section["apiVersion"])
+	}
+	if _, ok := section["source"].(map[string]interface{})["credentials"]; ok {
+		t.Error("source credentials should be omitted for the local deployment")
+	}
+	creds := section["target"].(map[string]interface{})["credentials"].(map[string]interface{})
+	if creds["accessKey"] != "remote-user" || creds["secretKey"] != "s3cret" {
+		t.Errorf("target credentials = %v", creds)
+	}
+	retry := section["flags"].(map[string]interface{})["retry"].(map[string]interface{})
+	if retry["attempts"] != 3 {
+		t.Errorf("retry attempts = %v, want 3", retry["attempts"])
+	}
+}
+
+func TestRenderBatchJobYAML_keyrotateKMSOnlyWithSSEKMS(t *testing.T) {
+	block := map[string]interface{}{
+		"bucket":          "data",
+		"prefix":          "",
+		"encryption_type": "sse-s3",
+		"kms_key_id":      "my-key",
+		"kms_context":     "",
+		"filter":          []interface{}{},
+		"notify":          []interface{}{},
+		"retry":           []interface{}{},
+	}
+
+	if _, err := renderBatchJobYAML("keyrotate", block, "", batchJobSecrets{}); err == nil {
+		t.Fatal("expected error for kms_key_id with sse-s3")
+	}
+
+	block["encryption_type"] = "sse-kms"
+	rendered, err := renderBatchJobYAML("keyrotate", block, "", batchJobSecrets{})
+	if err != nil {
+		t.Fatalf("renderBatchJobYAML() error = %v", err)
+	}
+	if !strings.Contains(rendered, "key: my-key") {
+		t.Errorf("rendered job missing kms key:\n%s", rendered)
+	}
+}
+
+func TestValidateBatchJobAgainstTemplate_unknownSection(t *testing.T) {
+	rendered := "expire:\n  apiVersion: v1\n  bucket: b\n  rules: []\n  flags: {}\n"
+
+	err := validateBatchJobAgainstTemplate("expire", rendered, testExpireTemplate)
+	if err == nil || !strings.Contains(err.Error(), "flags") {
+		t.Fatalf("expected unknown key error mentioning flags, got %v", err)
+	}
+
+	if err := validateBatchJobAgainstTemplate("replicate", rendered, testExpireTemplate); err == nil {
+		t.Fatal("expected error when the template has no section for the job type")
+	}
+}
+
+const testReplicateTemplate = `replicate:
+  apiVersion: v1
+  source:
+    type: TYPE # valid values are "s3" or "minio"
+    bucket: BUCKET
+    prefix: PREFIX # 'PREFIX' is optional
+    endpoint: "http[s]://HOSTNAME:PORT"
+    # path: "on|off|auto" # "on" enables path-style bucket lookup.
+    credentials:
+      accessKey: ACCESS-KEY
+      secretKey: SECRET-KEY
+      # sessionToken: SESSION-TOKEN # Optional only available when rotating credentials are used
+  target:
+    type: TYPE # valid values are "s3" or "minio"
+    bucket: BUCKET
+    prefix: PREFIX # 'PREFIX' is optional
+  # NOTE: All flags are optional
+  flags:
+    filter:
+      newerThan: "7d" # match objects newer than this value (e.g. 7d10h31s)
+      olderThan: "7d" # match objects older than this value (e.g. 7d10h31s)
+
+      ## NOTE: tags are not supported when "source" is remote.
+      # tags:
+      #   - key: "name"
+      #     value: "pick*" # match objects with tag 'name', with all values starting with 'pick'
+    notify:
+      endpoint: "https://notify.endpoint" # notification endpoint to receive job status events
+`
+
+func TestValidateBatchJobAgainstTemplate_nestedKeys(t *testing.T) {
+	rendered := `replicate:
+  apiVersion: v1
+  source:
+    bucket: src
+    path: "on"
+    credentials:
+      accessKey: a
+      secretKey: b
+      sessionToken: c
+  target:
+    bucket: dst
+  flags:
+    filter:
+      olderThan: 7d
+      tags:
+        - key: env
+          value: dev
+`
+	if err := validateBatchJobAgainstTemplate("replicate", rendered, testReplicateTemplate); err != nil {
+		t.Errorf("keys documented in template comments should be accepted, got %v", err)
+	}
+
+	rendered += "      kmskey: key-id\n    retry:\n      attempts: 3\n"
+	err := validateBatchJobAgainstTemplate("replicate", rendered, testReplicateTemplate)
+	if err == nil || !strings.Contains(err.Error(), "flags.filter.kmskey, flags.retry") {
+		t.Fatalf("expected unknown nested keys flags.filter.kmskey and flags.retry, got %v", err)
+	}
+
+	rendered = "expire:\n  apiVersion: v1\n  bucket: b\n  rules:\n    - type: deleted\n      size:\n        lessThan: 1MiB\n        between: 2MiB\n"
+	err = validateBatchJobAgainstTemplate("expire", rendered, testExpireTemplate)
+	if err == nil || !strings.Contains(err.Error(), "rules.size.between") {
+		t.Fatalf("expected unknown key rules.size.between, got %v", err)
+	}
+}
+
+func TestBatchJobTemplateAPIVersion(t *testing.T) {
+	if got := batchJobTemplateAPIVersion(testExpireTemplate, "expire"); got != "v1" {
+		t.Errorf("batchJobTemplateAPIVersion() = %q, want v1", got)
+	}
+	if got := batchJobTemplateAPIVersion("not: [valid", "expire"); got != batchJobDefaultAPIVersion {
+		t.Errorf("batchJobTemplateAPIVersion() = %q, want default", got)
+	}
+}
@@ -167,6 +167,8 @@ func NewConfig(d *schema.ResourceData) *S3MinioConfig {
 		SkipBucketTagging:     getOptionalField(d, "skip_bucket_tagging", false).(bool),
 		S3CompatMode:          getOptionalField(d, "s3_compat_mode", false).(bool),
 		Edition:               getOptionalField(d, "minio_edition", "").(string),
+		DefaultTags:           expandDefaultTags(d),
+		ValidateAgainstServer: getOptionalField(d, "validate_against_server", false).(bool),
 		RequestTimeoutSeconds: getOptionalField(d, "request_timeout_seconds", 30).(int),
 		MaxRetries:            getOptionalField(d, "max_retries", 6).(int),
 		RetryDelayMs:          getOptionalField(d, "retry_delay_ms", 1000).(int),
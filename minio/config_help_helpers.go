@@ -0,0 +1,109 @@
+package minio
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// configKeysAlwaysAllowed are accepted by every subsystem even though the
+// server's help does not list them.
+var configKeysAlwaysAllowed = map[string]bool{
+	"comment": true,
+	"enable":  true,
+}
+
+// configFreeFormTypes are help types whose values the server does not restrict.
+var configFreeFormTypes = map[string]bool{
+	"address":  true,
+	"csv":      true,
+	"path":     true,
+	"sentence": true,
+	"string":   true,
+	"uri":      true,
+	"url":      true,
+}
+
+// configHelpKey is the server's help for a single key of a config subsystem.
+type configHelpKey struct {
+	Type        string
+	Description string
+}
+
+// configSubsystem returns the subsystem of a config key, dropping any
+// target name (e.g. "notify_webhook:primary" -> "notify_webhook").
+func configSubsystem(key string) string {
+	subsys, _, _ := strings.Cut(key, ":")
+	return subsys
+}
+
+// validateConfigParams checks key=value pairs against the server's help for
+// the subsystem. Unknown keys and values that don't match the key's type are
+// rejected with the server's description of the key.
+func validateConfigParams(subsys string, help map[string]configHelpKey, params map[string]string) error {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []string
+	for _, name := range names {
+		value := params[name]
+		keyHelp, ok := help[name]
+		if !ok {
+			if configKeysAlwaysAllowed[name] {
+				continue
+			}
+			errs = append(errs, fmt.Sprintf("unknown key %q for subsystem %q, valid keys are: %s", name, subsys, strings.Join(sortedConfigHelpKeys(help), ", ")))
+			continue
+		}
+
+		if value == "" || configValueMatchesType(value, keyHelp.Type) {
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("invalid value %q for %s.%s: expected %s (%s)", value, subsys, name, keyHelp.Type, keyHelp.Description))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// configValueMatchesType checks a value against a help type. Types such as
+// "on|off" list the accepted literals, which may be mixed with types
+// (e.g. "on|off|duration").
+func configValueMatchesType(value, typ string) bool {
+	alternatives := strings.Split(typ, "|")
+	for _, alt := range alternatives {
+		switch alt {
+		case "duration":
+			if _, err := time.ParseDuration(value); err == nil {
+				return true
+			}
+		case "number":
+			if _, err := strconv.ParseFloat(value, 64); err == nil {
+				return true
+			}
+		default:
+			// A single type we don't know how to check is accepted as is;
+			// within a list of alternatives, anything else is a literal.
+			if alt == value || configFreeFormTypes[alt] || len(alternatives) == 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedConfigHelpKeys(help map[string]configHelpKey) []string {
+	keys := make([]string, 0, len(help))
+	for k := range help {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,57 @@
+package minio
+
+import (
+	"strings"
+	"testing"
+)
+
+var testAPIConfigHelp = map[string]configHelpKey{
+	"requests_max":         {Type: "number", Description: "set the maximum number of concurrent requests"},
+	"stale_uploads_expiry": {Type: "duration", Description: "set to expire stale multipart uploads older than this values"},
+	"root_access":          {Type: "on|off", Description: "turn 'off' root credential access for all API calls"},
+	"cors_allow_origin":    {Type: "csv", Description: "set comma separated list of origins allowed for CORS requests"},
+	"bitrotscan":           {Type: "on|off|duration", Description: "perform bitrot scan on drives when checking objects during scanner"},
+}
+
+func TestConfigSubsystem(t *testing.T) {
+	for key, want := range map[string]string{
+		"api":                    "api",
+		"notify_webhook:primary": "notify_webhook",
+		"identity_openid:":       "identity_openid",
+	} {
+		if got := configSubsystem(key); got != want {
+			t.Errorf("configSubsystem(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestValidateConfigParams(t *testing.T) {
+	valid := map[string]string{
+		"requests_max":         "1000",
+		"stale_uploads_expiry": "24h",
+		"root_access":          "off",
+		"cors_allow_origin":    "https://a.example.com,https://b.example.com",
+		"bitrotscan":           "12h",
+		"comment":              "managed",
+	}
+	if err := validateConfigParams("api", testAPIConfigHelp, valid); err != nil {
+		t.Errorf("validateConfigParams() unexpected error = %v", err)
+	}
+
+	cases := map[string]struct {
+		params map[string]string
+		want   string
+	}{
+		"unknown key":   {map[string]string{"request_max": "10"}, `unknown key "request_max"`},
+		"invalid enum":  {map[string]string{"root_access": "disabled"}, "expected on|off"},
+		"invalid type":  {map[string]string{"requests_max": "lots"}, "set the maximum number of concurrent requests"},
+		"bad duration":  {map[string]string{"stale_uploads_expiry": "1 day"}, "expected duration"},
+		"mixed literal": {map[string]string{"bitrotscan": "sometimes"}, "expected on|off|duration"},
+	}
+	for name, tc := range cases {
+		err := validateConfigParams("api", testAPIConfigHelp, tc.params)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: validateConfigParams() error = %v, want error containing %q", name, err, tc.want)
+		}
+	}
+}
@@ -2,12 +2,14 @@ package minio
 
 import (
 	"context"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/minio/madmin-go/v4"
 )
 
 func dataSourceIAMUsers() *schema.Resource {
@@ -50,15 +52,45 @@ func dataSourceIAMUsersRead(d *schema.ResourceData, meta interface{}) error {
 	m := meta.(*S3MinioClient)
 	admin := m.S3Admin
 
-	usersMap, err := admin.ListUsers(context.Background())
+	prefix := strings.TrimSpace(d.Get("name_prefix").(string))
+	wantStatus := strings.ToLower(strings.TrimSpace(d.Get("status").(string)))
+
+	users, err := listIAMUsers(context.Background(), admin, prefix, wantStatus)
 	if err != nil {
 		return err
 	}
 
-	prefix := strings.TrimSpace(d.Get("name_prefix").(string))
-	wantStatus := strings.ToLower(strings.TrimSpace(d.Get("status").(string)))
-
 	var out []map[string]interface{}
+	for _, user := range users {
+		out = append(out, map[string]interface{}{
+			"name":             user.name,
+			"status":           user.status,
+			"policy_names":     []string{},
+			"member_of_groups": []string{},
+		})
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	_ = d.Set("users", out)
+	return nil
+}
+
+type iamUserSummary struct {
+	name   string
+	status string
+}
+
+// listIAMUsers returns the users starting with prefix (all users when prefix
+// is empty) whose status is wantStatus ("enabled", "disabled" or "all"),
+// sorted by name. It is shared by the minio_iam_users data source and the
+// minio_iam_user list resource.
+func listIAMUsers(ctx context.Context, admin *madmin.AdminClient, prefix, wantStatus string) ([]iamUserSummary, error) {
+	usersMap, err := admin.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []iamUserSummary
 	for name, ui := range usersMap {
 		if prefix != "" && !strings.HasPrefix(name, prefix) {
 			continue
@@ -80,15 +112,9 @@ func dataSourceIAMUsersRead(d *schema.ResourceData, meta interface{}) error {
 			continue
 		}
 
-		out = append(out, map[string]interface{}{
-			"name":             name,
-			"status":           status,
-			"policy_names":     []string{},
-			"member_of_groups": []string{},
-		})
+		out = append(out, iamUserSummary{name: name, status: status})
 	}
 
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-	_ = d.Set("users", out)
-	return nil
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+	return out, nil
 }
@@ -39,23 +39,13 @@ func dataSourceMinioBatchJobTemplateRead(ctx context.Context, d *schema.Resource
 	admin := meta.(*S3MinioClient).S3Admin
 
 	jobType := d.Get("job_type").(string)
-	opts := madmin.GenerateBatchJobOpts{Type: madmin.BatchJobType(jobType)}
 
 	tflog.Debug(ctx, fmt.Sprintf("Generating batch job template for type: %s", jobType))
 
-	tmpl, apiUnavailable, err := admin.GenerateBatchJobV2(ctx, opts)
-	source := "server"
+	tmpl, source, err := fetchBatchJobTemplate(ctx, admin, jobType)
 	if err != nil {
 		return NewResourceError("generating batch job template", jobType, err)
 	}
-	if apiUnavailable {
-		tflog.Debug(ctx, fmt.Sprintf("GenerateBatchJobV2 unavailable, falling back to SDK template for type: %s", jobType))
-		tmpl, err = admin.GenerateBatchJob(ctx, opts)
-		if err != nil {
-			return NewResourceError("generating batch job template (fallback)", jobType, err)
-		}
-		source = "sdk"
-	}
 
 	if err := d.Set("yaml", tmpl); err != nil {
 		return NewResourceError("setting yaml", jobType, err)
@@ -68,3 +58,24 @@ func dataSourceMinioBatchJobTemplateRead(ctx context.Context, d *schema.Resource
 
 	return nil
 }
+
+// fetchBatchJobTemplate returns the job template for jobType from the server,
+// falling back to the SDK's bundled template when GenerateBatchJobV2 is unavailable.
+func fetchBatchJobTemplate(ctx context.Context, admin *madmin.AdminClient, jobType string) (string, string, error) {
+	opts := madmin.GenerateBatchJobOpts{Type: madmin.BatchJobType(jobType)}
+
+	tmpl, apiUnavailable, err := admin.GenerateBatchJobV2(ctx, opts)
+	if err != nil {
+		return "", "", err
+	}
+	if !apiUnavailable {
+		return tmpl, "server", nil
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("GenerateBatchJobV2 unavailable, falling back to SDK template for type: %s", jobType))
+	tmpl, err = admin.GenerateBatchJob(ctx, opts)
+	if err != nil {
+		return "", "", fmt.Errorf("fallback template: %w", err)
+	}
+	return tmpl, "sdk", nil
+}
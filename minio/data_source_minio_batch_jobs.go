@@ -65,6 +65,31 @@ func dataSourceMinioBatchJobs() *schema.Resource {
 							Computed:    true,
 							Description: "Job start time in RFC3339 format.",
 						},
+						"objects": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of objects processed so far.",
+						},
+						"objects_failed": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of objects that failed processing.",
+						},
+						"bytes_transferred": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bytes transferred so far. Only reported for replicate jobs.",
+						},
+						"last_update": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the last progress update, in RFC3339 format.",
+						},
+						"retry_attempts": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of retry attempts made by the job.",
+						},
 					},
 				},
 			},
@@ -89,6 +114,7 @@ func dataSourceMinioBatchJobsRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	statuses := make([]string, len(result.Jobs))
+	progress := make([]map[string]interface{}, len(result.Jobs))
 	g, gCtx := errgroup.WithContext(ctx)
 	g.SetLimit(10)
 
@@ -99,9 +125,11 @@ func dataSourceMinioBatchJobsRead(ctx context.Context, d *schema.ResourceData, m
 			if err != nil {
 				tflog.Debug(ctx, fmt.Sprintf("BatchJobStatus unavailable for %s: %v", job.ID, err))
 				statuses[i] = "started"
+				progress[i] = flattenBatchJobProgress(madmin.BatchJobStatus{})
 				return nil
 			}
 			statuses[i] = bareStatus(status)
+			progress[i] = flattenBatchJobProgress(status)
 			return nil
 		})
 	}
@@ -134,6 +162,9 @@ func dataSourceMinioBatchJobsRead(ctx context.Context, d *schema.ResourceData, m
 			"user":     job.User,
 			"started":  startedStr,
 		}
+		for k, v := range progress[i] {
+			jobMap[k] = v
+		}
 		filteredJobs = append(filteredJobs, jobMap)
 	}
 
@@ -148,6 +179,39 @@ func dataSourceMinioBatchJobsRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// flattenBatchJobProgress extracts the progress counters of the job-type
+// specific metric reported by BatchJobStatus.
+func flattenBatchJobProgress(status madmin.BatchJobStatus) map[string]interface{} {
+	metric := status.LastMetric
+
+	var objects, objectsFailed, bytesTransferred int64
+	switch {
+	case metric.Replicate != nil:
+		objects = metric.Replicate.Objects
+		objectsFailed = metric.Replicate.ObjectsFailed
+		bytesTransferred = metric.Replicate.BytesTransferred
+	case metric.Expired != nil:
+		objects = metric.Expired.Objects
+		objectsFailed = metric.Expired.ObjectsFailed
+	case metric.Rotate != nil:
+		objects = metric.Rotate.Objects
+		objectsFailed = metric.Rotate.ObjectsFailed
+	}
+
+	lastUpdate := ""
+	if !metric.LastUpdate.IsZero() {
+		lastUpdate = metric.LastUpdate.Format(time.RFC3339)
+	}
+
+	return map[string]interface{}{
+		"objects":           int(objects),
+		"objects_failed":    int(objectsFailed),
+		"bytes_transferred": int(bytesTransferred),
+		"last_update":       lastUpdate,
+		"retry_attempts":    metric.RetryAttempts,
+	}
+}
+
 func bareStatus(status madmin.BatchJobStatus) string {
 	if status.LastMetric.Failed {
 		return "failed"
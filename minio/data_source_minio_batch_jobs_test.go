@@ -2,6 +2,9 @@ package minio
 
 import (
 	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v4"
 )
 
 func TestAccDataSourceMinioBatchJobs_basic(t *testing.T) {
@@ -11,3 +14,32 @@ func TestAccDataSourceMinioBatchJobs_basic(t *testing.T) {
 func TestAccDataSourceMinioBatchJobs_filterByType(t *testing.T) {
 	t.Skip("Batch job tests require a pre-configured bucket and multi-cluster replication setup not available in the shared CI fixture. To run manually, create batch jobs on the MinIO instance, then run with TF_ACC=1.")
 }
+
+func TestFlattenBatchJobProgress(t *testing.T) {
+	lastUpdate := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	replicate := madmin.BatchJobStatus{LastMetric: madmin.JobMetric{
+		LastUpdate:    lastUpdate,
+		RetryAttempts: 2,
+		Replicate: &madmin.ReplicateInfo{
+			Objects:          10,
+			ObjectsFailed:    1,
+			BytesTransferred: 2048,
+		},
+	}}
+	got := flattenBatchJobProgress(replicate)
+	if got["objects"] != 10 || got["objects_failed"] != 1 || got["bytes_transferred"] != 2048 {
+		t.Errorf("unexpected replicate progress: %v", got)
+	}
+	if got["last_update"] != "2025-01-02T03:04:05Z" || got["retry_attempts"] != 2 {
+		t.Errorf("unexpected replicate metadata: %v", got)
+	}
+
+	rotate := madmin.BatchJobStatus{LastMetric: madmin.JobMetric{
+		Rotate: &madmin.RotationInfo{Objects: 5, ObjectsFailed: 0},
+	}}
+	got = flattenBatchJobProgress(rotate)
+	if got["objects"] != 5 || got["bytes_transferred"] != 0 || got["last_update"] != "" {
+		t.Errorf("unexpected keyrotate progress: %v", got)
+	}
+}
@@ -0,0 +1,253 @@
+package minio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// bucketAccessActions are the actions summarized per bucket by
+// minio_iam_effective_policy.
+var bucketAccessActions = map[string]policy.Action{
+	"read":   policy.GetObjectAction,
+	"write":  policy.PutObjectAction,
+	"delete": policy.DeleteObjectAction,
+	"list":   policy.ListBucketAction,
+}
+
+func dataSourceMinioIAMEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Computes the effective policy of a user, LDAP user DN or service account from the policies attached directly " +
+			"and the policies inherited from groups. For service accounts, the inline session policy is returned separately, " +
+			"as it limits the parent's policies rather than adding to them. " +
+			"Also summarizes the unconditional access granted on each bucket referenced by the policies.",
+		ReadContext: dataSourceMinioIAMEffectivePolicyRead,
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"user", "service_account"},
+				Description:  "Name of the user or DN of the LDAP user.",
+			},
+			"service_account": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Access key of the service account. Its parent user's policies are included.",
+			},
+			"parent_user": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Parent user of the service account. Equal to `user` otherwise.",
+			},
+			"direct_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Policies attached directly to the user.",
+			},
+			"group_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies inherited from group membership.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group":  {Type: schema.TypeString, Computed: true},
+						"policy": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"policy_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Deduplicated list of all effective policy names.",
+			},
+			"session_policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Inline policy of the service account, which further limits the parent's policies. Empty when it inherits its parent's policies.",
+			},
+			"policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Policy JSON with the statements of all attached policies concatenated into one document. The session policy of a service account is not included, see `session_policy`.",
+			},
+			"bucket_access": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "Unconditional access granted on each bucket (or bucket pattern) referenced by the policies. " +
+					"Object-level access counts as granted when it is allowed on at least one prefix of the bucket. " +
+					"For service accounts with a session policy, access must be allowed by both the parent's policies and the session policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {Type: schema.TypeString, Computed: true, Description: "Bucket name or pattern."},
+						"read":   {Type: schema.TypeBool, Computed: true, Description: "Whether `s3:GetObject` is allowed."},
+						"write":  {Type: schema.TypeBool, Computed: true, Description: "Whether `s3:PutObject` is allowed."},
+						"delete": {Type: schema.TypeBool, Computed: true, Description: "Whether `s3:DeleteObject` is allowed."},
+						"list":   {Type: schema.TypeBool, Computed: true, Description: "Whether `s3:ListBucket` is allowed."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMinioIAMEffectivePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	id := d.Get("user").(string)
+	user := id
+	var session *namedPolicy
+
+	if accessKey := d.Get("service_account").(string); accessKey != "" {
+		id = accessKey
+		info, err := admin.InfoServiceAccount(ctx, accessKey)
+		if err != nil {
+			return NewResourceError("reading service account", accessKey, err)
+		}
+		user = info.ParentUser
+		if !info.ImpliedPolicy && strings.TrimSpace(info.Policy) != "" {
+			p, err := policy.ParseConfig(strings.NewReader(info.Policy))
+			if err != nil {
+				return NewResourceError("parsing session policy", accessKey, err)
+			}
+			session = &namedPolicy{name: "session", document: []byte(info.Policy), policy: p}
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Computing effective policy of %s (parent user %s)", id, user))
+
+	principal, diags := readPrincipalPolicies(ctx, admin, user)
+	if diags != nil {
+		return diags
+	}
+	names := principal.names()
+	policies, diags := fetchCannedPolicies(ctx, admin, names)
+	if diags != nil {
+		return diags
+	}
+
+	merged, err := concatPolicyDocuments(policies)
+	if err != nil {
+		return NewResourceError("decoding policies", id, err)
+	}
+	mergedJSON, err := json.MarshalIndent(merged, "", "  ")
+	if err != nil {
+		return NewResourceError("encoding effective policy", id, err)
+	}
+
+	var group []map[string]interface{}
+	for _, g := range principal.groups {
+		for _, p := range g.policies {
+			group = append(group, map[string]interface{}{"group": g.group, "policy": p})
+		}
+	}
+
+	sessionPolicy := ""
+	if session != nil {
+		sessionPolicy = string(session.document)
+	}
+
+	d.SetId(id)
+	_ = d.Set("parent_user", user)
+	_ = d.Set("direct_policies", principal.direct)
+	_ = d.Set("policy_names", names)
+	_ = d.Set("session_policy", sessionPolicy)
+	_ = d.Set("policy", string(mergedJSON))
+	if err := d.Set("group_policies", group); err != nil {
+		return NewResourceError("setting group_policies", id, err)
+	}
+	if err := d.Set("bucket_access", summarizeBucketAccess(policies, session)); err != nil {
+		return NewResourceError("setting bucket_access", id, err)
+	}
+
+	return nil
+}
+
+// concatPolicyDocuments returns one policy document holding the statements of
+// every policy, in order. Statements are kept even when their Sid repeats one
+// from another policy, since each attached policy grants access on its own.
+func concatPolicyDocuments(policies []namedPolicy) (*IAMPolicyDoc, error) {
+	doc := &IAMPolicyDoc{Statements: []*IAMPolicyStatement{}}
+	for _, p := range policies {
+		pd := &IAMPolicyDoc{}
+		if err := json.Unmarshal(p.document, pd); err != nil {
+			return nil, fmt.Errorf("decoding policy %s: %w", p.name, err)
+		}
+		if pd.Version > doc.Version {
+			doc.Version = pd.Version
+		}
+		doc.Statements = append(doc.Statements, pd.Statements...)
+	}
+	return doc, nil
+}
+
+// summarizeBucketAccess evaluates the bucket access actions against every
+// bucket and object pattern referenced by the allow statements of policies.
+// When session is set, an action must also be allowed by it.
+func summarizeBucketAccess(policies []namedPolicy, session *namedPolicy) []map[string]interface{} {
+	objectPatterns := map[string]map[string]bool{}
+	for _, p := range policies {
+		for _, statement := range p.policy.Statements {
+			if statement.Effect != policy.Allow {
+				continue
+			}
+			for r := range statement.Resources {
+				if r.Type != policy.ResourceARNS3 && r.Type != policy.ResourceARNAll {
+					continue
+				}
+				bucket, object, _ := strings.Cut(r.Pattern, "/")
+				if objectPatterns[bucket] == nil {
+					objectPatterns[bucket] = map[string]bool{}
+				}
+				if object == "" {
+					object = "*"
+				}
+				objectPatterns[bucket][object] = true
+			}
+		}
+	}
+
+	buckets := make([]string, 0, len(objectPatterns))
+	for bucket := range objectPatterns {
+		buckets = append(buckets, bucket)
+	}
+	sort.Strings(buckets)
+
+	allowed := func(args policy.Args) bool {
+		if decision, _, _ := evaluatePolicies(policies, args); decision != policyDecisionAllowed {
+			return false
+		}
+		return session == nil || session.policy.IsAllowed(args)
+	}
+
+	summary := make([]map[string]interface{}, 0, len(buckets))
+	for _, bucket := range buckets {
+		access := map[string]interface{}{"bucket": bucket}
+		for key, action := range bucketAccessActions {
+			args := policy.Args{Action: action, BucketName: bucket, ConditionValues: map[string][]string{}}
+			if action == policy.ListBucketAction {
+				access[key] = allowed(args)
+				continue
+			}
+			access[key] = false
+			for object := range objectPatterns[bucket] {
+				args.ObjectName = object
+				if allowed(args) {
+					access[key] = true
+					break
+				}
+			}
+		}
+		summary = append(summary, access)
+	}
+
+	return summary
+}
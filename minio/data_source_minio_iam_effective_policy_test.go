@@ -0,0 +1,216 @@
+package minio
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/minio/pkg/v3/policy"
+)
+
+func TestAccDataSourceMinioIAMEffectivePolicy_user(t *testing.T) {
+	name := "tfacc-eff-" + acctest.RandString(6)
+	dataSourceName := "data.minio_iam_effective_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectivePolicyUserConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "parent_user", name),
+					resource.TestCheckResourceAttr(dataSourceName, "direct_policies.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "direct_policies.0", name+"-read"),
+					resource.TestCheckResourceAttr(dataSourceName, "group_policies.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "group_policies.0.group", name),
+					resource.TestCheckResourceAttr(dataSourceName, "group_policies.0.policy", name+"-write"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_access.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_access.0.bucket", name),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_access.0.read", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_access.0.write", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_access.0.delete", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceMinioIAMEffectivePolicy_serviceAccount(t *testing.T) {
+	name := "tfacc-eff-" + acctest.RandString(6)
+	dataSourceName := "data.minio_iam_effective_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectivePolicyServiceAccountConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "parent_user", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "session_policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_access.0.read", "true"),
+					// The parent may write, but the session policy only allows reads.
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_access.0.write", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestSummarizeBucketAccess(t *testing.T) {
+	parse := func(doc string) *policy.Policy {
+		p, err := policy.ParseConfig(strings.NewReader(doc))
+		if err != nil {
+			t.Fatalf("parsing policy: %v", err)
+		}
+		return p
+	}
+	policies := []namedPolicy{{name: "reports", policy: parse(testPolicySimulationDocument)}}
+
+	summary := summarizeBucketAccess(policies, nil)
+	want := []map[string]interface{}{
+		{"bucket": "reports", "read": true, "write": false, "delete": false, "list": false},
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("summarizeBucketAccess() = %v, want %v", summary, want)
+	}
+
+	policies = append(policies, namedPolicy{name: "rw", policy: parse(`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": ["s3:*"], "Resource": ["arn:aws:s3:::logs", "arn:aws:s3:::logs/*"]}]
+}`)})
+	session := &namedPolicy{name: "session", policy: parse(`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": ["arn:aws:s3:::*"]}]
+}`)}
+
+	summary = summarizeBucketAccess(policies, session)
+	want = []map[string]interface{}{
+		{"bucket": "logs", "read": true, "write": false, "delete": false, "list": true},
+		{"bucket": "reports", "read": true, "write": false, "delete": false, "list": false},
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("summarizeBucketAccess() with session = %v, want %v", summary, want)
+	}
+}
+
+func TestConcatPolicyDocuments(t *testing.T) {
+	policies := []namedPolicy{
+		{name: "read", document: []byte(`{"Version":"2012-10-17","Statement":[{"Sid":"Objects","Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::reports/*"]}]}`)},
+		{name: "write", document: []byte(`{"Version":"2012-10-17","Statement":[{"Sid":"Objects","Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::logs/*"]}]}`)},
+	}
+
+	doc, err := concatPolicyDocuments(policies)
+	if err != nil {
+		t.Fatalf("concatPolicyDocuments() error = %v", err)
+	}
+	if doc.Version != "2012-10-17" {
+		t.Errorf("Version = %q, want 2012-10-17", doc.Version)
+	}
+	if len(doc.Statements) != 2 {
+		t.Fatalf("got %d statements, want both statements with Sid Objects", len(doc.Statements))
+	}
+	if !reflect.DeepEqual(doc.Statements[0].Actions, []interface{}{"s3:GetObject"}) ||
+		!reflect.DeepEqual(doc.Statements[1].Actions, []interface{}{"s3:PutObject"}) {
+		t.Errorf("statements = %v, %v, want the read statement then the write statement", doc.Statements[0].Actions, doc.Statements[1].Actions)
+	}
+
+	if _, err := concatPolicyDocuments([]namedPolicy{{name: "broken", document: []byte(`{`)}}); err == nil {
+		t.Error("concatPolicyDocuments() with an invalid document: expected an error")
+	}
+}
+
+func testAccEffectivePolicyUserConfig(name string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "minio_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "minio_iam_group_membership" "test" {
+  name  = %[1]q
+  group = minio_iam_group.test.name
+  users = [minio_iam_user.test.name]
+}
+
+resource "minio_iam_policy" "read" {
+  name   = "%[1]s-read"
+  policy = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = ["s3:GetObject"], Resource = ["arn:aws:s3:::%[1]s/*"] }]
+  })
+}
+
+resource "minio_iam_policy" "write" {
+  name   = "%[1]s-write"
+  policy = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = ["s3:PutObject"], Resource = ["arn:aws:s3:::%[1]s/*"] }]
+  })
+}
+
+resource "minio_iam_user_policy_attachment" "test" {
+  user_name   = minio_iam_user.test.name
+  policy_name = minio_iam_policy.read.name
+}
+
+resource "minio_iam_group_policy_attachment" "test" {
+  group_name  = minio_iam_group.test.name
+  policy_name = minio_iam_policy.write.name
+}
+
+data "minio_iam_effective_policy" "test" {
+  user = minio_iam_user.test.name
+
+  depends_on = [
+    minio_iam_group_membership.test,
+    minio_iam_user_policy_attachment.test,
+    minio_iam_group_policy_attachment.test,
+  ]
+}
+`, name)
+}
+
+func testAccEffectivePolicyServiceAccountConfig(name string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "minio_iam_policy" "rw" {
+  name   = %[1]q
+  policy = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = ["s3:GetObject", "s3:PutObject"], Resource = ["arn:aws:s3:::%[1]s/*"] }]
+  })
+}
+
+resource "minio_iam_user_policy_attachment" "test" {
+  user_name   = minio_iam_user.test.name
+  policy_name = minio_iam_policy.rw.name
+}
+
+resource "minio_iam_service_account" "test" {
+  target_user = minio_iam_user.test.name
+  policy = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = ["s3:GetObject"], Resource = ["arn:aws:s3:::%[1]s/*"] }]
+  })
+
+  depends_on = [minio_iam_user_policy_attachment.test]
+}
+
+data "minio_iam_effective_policy" "test" {
+  service_account = minio_iam_service_account.test.access_key
+}
+`, name)
+}
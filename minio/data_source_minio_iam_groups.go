@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
 )
 
 func dataSourceIAMGroups() *schema.Resource {
@@ -38,19 +39,14 @@ func dataSourceIAMGroups() *schema.Resource {
 func dataSourceIAMGroupsRead(d *schema.ResourceData, meta interface{}) error {
 	admin := meta.(*S3MinioClient).S3Admin
 
-	groupNames, err := admin.ListGroups(context.Background())
+	prefix := strings.TrimSpace(d.Get("name_prefix").(string))
+	groupNames, err := listIAMGroupNames(context.Background(), admin, prefix)
 	if err != nil {
 		return err
 	}
 
-	prefix := strings.TrimSpace(d.Get("name_prefix").(string))
-
 	var out []map[string]interface{}
 	for _, name := range groupNames {
-		if prefix != "" && !strings.HasPrefix(name, prefix) {
-			continue
-		}
-
 		desc, err := admin.GetGroupDescription(context.Background(), name)
 		if err != nil {
 			continue
@@ -68,3 +64,22 @@ func dataSourceIAMGroupsRead(d *schema.ResourceData, meta interface{}) error {
 	_ = d.Set("groups", out)
 	return nil
 }
+
+// listIAMGroupNames returns the names of the groups starting with prefix, or
+// of all groups when prefix is empty. It is shared by the minio_iam_groups
+// data source and the minio_iam_group list resource.
+func listIAMGroupNames(ctx context.Context, admin *madmin.AdminClient, prefix string) ([]string, error) {
+	groupNames, err := admin.ListGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []string
+	for _, name := range groupNames {
+		if prefix != "" && !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		out = append(out, name)
+	}
+	return out, nil
+}
@@ -0,0 +1,154 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
+)
+
+// idpOpenIdSecretKeys are configuration keys never exposed by the data source.
+var idpOpenIdSecretKeys = map[string]bool{
+	"client_secret": true,
+}
+
+func dataSourceMinioIAMIdpOpenIdProviders() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists all OpenID Connect identity provider configurations on the MinIO server with their settings and role ARNs. Client secrets are never returned.",
+		ReadContext: dataSourceMinioIAMIdpOpenIdProvidersRead,
+		Schema: map[string]*schema.Schema{
+			"providers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Configured OpenID providers.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the configuration (`_` for the primary configuration).",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the configuration is enabled.",
+						},
+						"role_arn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Role ARN of the configuration. Empty for claim-based configurations.",
+						},
+						"config_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the OpenID discovery document.",
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "OAuth2 client ID.",
+						},
+						"claim_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JWT claim used to look up policies.",
+						},
+						"claim_prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Prefix applied to claim values when looking up policies.",
+						},
+						"scopes": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comma-separated OAuth2 scopes requested.",
+						},
+						"redirect_uri": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "OAuth2 redirect URI.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name shown on the MinIO login screen.",
+						},
+						"role_policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Policy applied to users of a role-based configuration.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comment for the configuration.",
+						},
+						"settings": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "All configuration keys set for the provider, including ones not exposed as attributes. Secrets are omitted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMinioIAMIdpOpenIdProvidersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	minioAdmin := meta.(*S3MinioClient).S3Admin
+
+	tflog.Debug(ctx, "Listing OIDC IDP configurations")
+
+	items, err := minioAdmin.ListIDPConfig(ctx, madmin.OpenidIDPCfg)
+	if err != nil {
+		return NewResourceError("listing OIDC IDP configurations", madmin.OpenidIDPCfg, err)
+	}
+
+	providers := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		cfg, err := minioAdmin.GetIDPConfig(ctx, madmin.OpenidIDPCfg, item.Name)
+		if err != nil {
+			if isIDPConfigNotFound(err) {
+				tflog.Debug(ctx, fmt.Sprintf("OIDC IDP configuration %s listed but not readable, skipping", item.Name))
+				continue
+			}
+			return NewResourceError("reading OIDC IDP configuration", item.Name, err)
+		}
+
+		providers = append(providers, flattenIdpOpenIdProvider(item, idpCfgInfoToMap(cfg.Info)))
+	}
+
+	d.SetId(madmin.OpenidIDPCfg)
+	if err := d.Set("providers", providers); err != nil {
+		return NewResourceError("setting providers", madmin.OpenidIDPCfg, err)
+	}
+
+	return nil
+}
+
+func flattenIdpOpenIdProvider(item madmin.IDPListItem, cfgMap map[string]string) map[string]interface{} {
+	settings := make(map[string]interface{}, len(cfgMap))
+	for k, v := range cfgMap {
+		if idpOpenIdSecretKeys[k] {
+			continue
+		}
+		settings[k] = v
+	}
+
+	provider := map[string]interface{}{
+		"name":     item.Name,
+		"enabled":  item.Enabled,
+		"role_arn": item.RoleARN,
+		"settings": settings,
+	}
+	for _, key := range []string{"config_url", "client_id", "claim_name", "claim_prefix", "scopes", "redirect_uri", "display_name", "role_policy", "comment"} {
+		provider[key] = cfgMap[key]
+	}
+
+	return provider
+}
@@ -0,0 +1,102 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/minio/madmin-go/v4"
+)
+
+func dataSourceMinioIAMLDAPAccessKeys() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the access keys of an LDAP user.",
+		ReadContext: dataSourceMinioIAMLDAPAccessKeysRead,
+		Schema: map[string]*schema.Schema{
+			"user_dn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "DN of the LDAP user to list access keys for.",
+			},
+			"access_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Access keys of the LDAP user.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Access key ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the access key.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the access key.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the access key (`on` or `off`).",
+						},
+						"implied_policy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the access key inherits the policies of the LDAP user instead of having an inline policy.",
+						},
+						"expiration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiration of the access key in RFC3339 format. Empty when the access key does not expire.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMinioIAMLDAPAccessKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	userDN := d.Get("user_dn").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing LDAP access keys for %s", userDN))
+
+	resp, err := admin.ListAccessKeysLDAP(ctx, userDN, madmin.AccessKeyListSvcaccOnly)
+	if err != nil {
+		return NewResourceError("listing LDAP access keys", userDN, err)
+	}
+
+	keys := make([]map[string]interface{}, 0, len(resp.ServiceAccounts))
+	for _, info := range resp.ServiceAccounts {
+		var expiration string
+		if info.Expiration != nil && !info.Expiration.IsZero() && info.Expiration.Unix() != 0 {
+			expiration = info.Expiration.Format(time.RFC3339)
+		}
+		keys = append(keys, map[string]interface{}{
+			"access_key":     info.AccessKey,
+			"name":           info.Name,
+			"description":    info.Description,
+			"status":         info.AccountStatus,
+			"implied_policy": info.ImpliedPolicy,
+			"expiration":     expiration,
+		})
+	}
+
+	d.SetId(userDN)
+	if err := d.Set("access_keys", keys); err != nil {
+		return NewResourceError("setting access_keys", userDN, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
+)
+
+func dataSourceMinioIAMLDAPPolicyEntities() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads the policy mappings of LDAP users and groups that exist on the MinIO server. " +
+			"Without filters, every mapping is returned, which makes it possible to detect mappings created outside Terraform.",
+		ReadContext: dataSourceMinioIAMLDAPPolicyEntitiesRead,
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return mappings for these LDAP user DNs.",
+			},
+			"groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return mappings for these LDAP group DNs.",
+			},
+			"policies": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return mappings for these policies.",
+			},
+			"timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Server time at which the mappings were read.",
+			},
+			"policy_mappings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "LDAP users and groups mapped to each policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Policy name.",
+						},
+						"users": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "LDAP user DNs mapped to the policy.",
+						},
+						"groups": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "LDAP group DNs mapped to the policy.",
+						},
+					},
+				},
+			},
+			"user_mappings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies mapped to each LDAP user.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "LDAP user DN.",
+						},
+						"policies": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Policies mapped directly to the user.",
+						},
+						"member_of": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Groups the user belongs to that have policies mapped.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "LDAP group DN.",
+									},
+									"policies": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Policies mapped to the group.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"group_mappings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies mapped to each LDAP group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "LDAP group DN.",
+						},
+						"policies": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Policies mapped to the group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMinioIAMLDAPPolicyEntitiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	query := madmin.PolicyEntitiesQuery{
+		Users:  sortedStringSet(d.Get("users").(*schema.Set)),
+		Groups: sortedStringSet(d.Get("groups").(*schema.Set)),
+		Policy: sortedStringSet(d.Get("policies").(*schema.Set)),
+	}
+	id := ldapPolicyEntitiesID(query)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading LDAP policy entities (%s)", id))
+
+	result, err := admin.GetLDAPPolicyEntities(ctx, query)
+	if err != nil {
+		return NewResourceError("reading LDAP policy entities", id, err)
+	}
+
+	policyMappings := make([]map[string]interface{}, 0, len(result.PolicyMappings))
+	for _, m := range result.PolicyMappings {
+		policyMappings = append(policyMappings, map[string]interface{}{
+			"policy": m.Policy,
+			"users":  m.Users,
+			"groups": m.Groups,
+		})
+	}
+
+	userMappings := make([]map[string]interface{}, 0, len(result.UserMappings))
+	for _, m := range result.UserMappings {
+		userMappings = append(userMappings, map[string]interface{}{
+			"user":      m.User,
+			"policies":  m.Policies,
+			"member_of": flattenLDAPGroupPolicyEntities(m.MemberOfMappings),
+		})
+	}
+
+	d.SetId(id)
+	_ = d.Set("timestamp", result.Timestamp.Format(time.RFC3339))
+	if err := d.Set("policy_mappings", policyMappings); err != nil {
+		return NewResourceError("setting policy_mappings", id, err)
+	}
+	if err := d.Set("user_mappings", userMappings); err != nil {
+		return NewResourceError("setting user_mappings", id, err)
+	}
+	if err := d.Set("group_mappings", flattenLDAPGroupPolicyEntities(result.GroupMappings)); err != nil {
+		return NewResourceError("setting group_mappings", id, err)
+	}
+
+	return nil
+}
+
+func flattenLDAPGroupPolicyEntities(mappings []madmin.GroupPolicyEntities) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(mappings))
+	for _, m := range mappings {
+		out = append(out, map[string]interface{}{
+			"group":    m.Group,
+			"policies": m.Policies,
+		})
+	}
+	return out
+}
+
+func sortedStringSet(set *schema.Set) []string {
+	values := getStringList(set.List())
+	sort.Strings(values)
+	return values
+}
+
+// ldapPolicyEntitiesID derives a stable ID from the query filters.
+func ldapPolicyEntitiesID(query madmin.PolicyEntitiesQuery) string {
+	if len(query.Users) == 0 && len(query.Groups) == 0 && len(query.Policy) == 0 {
+		return "all"
+	}
+	return fmt.Sprintf("users=%s;groups=%s;policies=%s",
+		strings.Join(query.Users, "|"), strings.Join(query.Groups, "|"), strings.Join(query.Policy, "|"))
+}
@@ -0,0 +1,74 @@
+package minio
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/minio/madmin-go/v4"
+)
+
+func TestAccDataSourceMinioIAMLDAPPolicyEntities_basic(t *testing.T) {
+	userDN := os.Getenv("MINIO_LDAP_TEST_USER_DN")
+	groupDN := os.Getenv("MINIO_LDAP_TEST_GROUP_DN")
+	dataSourceName := "data.minio_iam_ldap_policy_entities.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccLDAPAttachmentPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPPolicyEntitiesConfig(userDN, groupDN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "timestamp"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_mappings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_mappings.0.policy", "readonly"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "policy_mappings.0.users.*", userDN),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "policy_mappings.0.groups.*", groupDN),
+				),
+			},
+		},
+	})
+}
+
+func TestLDAPPolicyEntitiesID(t *testing.T) {
+	if got := ldapPolicyEntitiesID(madmin.PolicyEntitiesQuery{}); got != "all" {
+		t.Errorf("ldapPolicyEntitiesID(empty) = %q, want %q", got, "all")
+	}
+
+	got := ldapPolicyEntitiesID(madmin.PolicyEntitiesQuery{
+		Users:  []string{"uid=a,dc=org", "uid=b,dc=org"},
+		Policy: []string{"readonly"},
+	})
+	want := "users=uid=a,dc=org|uid=b,dc=org;groups=;policies=readonly"
+	if got != want {
+		t.Errorf("ldapPolicyEntitiesID = %q, want %q", got, want)
+	}
+}
+
+func testAccLDAPPolicyEntitiesConfig(userDN, groupDN string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_ldap_user_policy_attachment" "test" {
+  provider    = ldapminio
+  user_dn     = %[1]q
+  policy_name = "readonly"
+}
+
+resource "minio_iam_ldap_group_policy_attachment" "test" {
+  provider    = ldapminio
+  group_dn    = %[2]q
+  policy_name = "readonly"
+}
+
+data "minio_iam_ldap_policy_entities" "test" {
+  provider = ldapminio
+  policies = ["readonly"]
+
+  depends_on = [
+    minio_iam_ldap_user_policy_attachment.test,
+    minio_iam_ldap_group_policy_attachment.test,
+  ]
+}
+`, userDN, groupDN)
+}
@@ -0,0 +1,357 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/minio/madmin-go/v4"
+	"github.com/minio/pkg/v3/policy"
+)
+
+const (
+	policyDecisionAllowed      = "allowed"
+	policyDecisionExplicitDeny = "explicitDeny"
+	policyDecisionImplicitDeny = "implicitDeny"
+)
+
+// namedPolicy is a parsed policy document together with the name it is
+// reported under (the canned policy name or policy_documents index).
+type namedPolicy struct {
+	name     string
+	document []byte
+	policy   *policy.Policy
+}
+
+func dataSourceMinioIAMPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Evaluates whether a set of IAM policies allows actions on resources, using the MinIO policy engine. " +
+			"Policies are given as documents, as canned policy names, or taken from the effective policies of a user on the server. " +
+			"Use the results in `precondition` and `check` blocks to verify access before granting it.",
+		ReadContext: dataSourceMinioIAMPolicySimulationRead,
+		Schema: map[string]*schema.Schema{
+			"policy_documents": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validateIAMPolicyJSON},
+				AtLeastOneOf: []string{"policy_documents", "policy_names", "user"},
+				Description:  "Policy documents (JSON) to evaluate.",
+			},
+			"policy_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of canned policies on the server to evaluate.",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User or LDAP user DN whose effective policies (attached directly and through groups) are evaluated.",
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotWhiteSpace},
+				Description: "Actions to evaluate (e.g. `s3:GetObject`).",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotWhiteSpace},
+				Description: "Resources to evaluate, as ARNs (`arn:aws:s3:::bucket/key`) or `bucket/key`.",
+			},
+			"context": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Request context used to evaluate policy conditions and variables.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_ip": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPAddress,
+							Description:  "Client IP address (`aws:SourceIp`).",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Listing prefix (`s3:prefix`).",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "User name (`aws:username`, `${aws:username}`). Defaults to `user` when set.",
+						},
+					},
+				},
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Decision for each action and resource combination.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Evaluated action.",
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Evaluated resource.",
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the action is allowed on the resource.",
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "`allowed`, `explicitDeny` or `implicitDeny`.",
+						},
+						"matched_policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Policy containing the deciding statement: the canned policy name or `policy_documents[<index>]`.",
+						},
+						"matched_statement_sid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Sid of the deciding statement. Empty for implicit denies and statements without Sid.",
+						},
+					},
+				},
+			},
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every action is allowed on every resource.",
+			},
+		},
+	}
+}
+
+func dataSourceMinioIAMPolicySimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	user := d.Get("user").(string)
+
+	var policies []namedPolicy
+	for i, doc := range d.Get("policy_documents").([]interface{}) {
+		p, err := policy.ParseConfig(strings.NewReader(doc.(string)))
+		if err != nil {
+			return NewResourceError("parsing policy document", fmt.Sprintf("policy_documents[%d]", i), err)
+		}
+		policies = append(policies, namedPolicy{name: fmt.Sprintf("policy_documents[%d]", i), document: []byte(doc.(string)), policy: p})
+	}
+
+	names := getStringList(d.Get("policy_names").([]interface{}))
+	if user != "" {
+		userPolicies, diags := readPrincipalPolicies(ctx, admin, user)
+		if diags != nil {
+			return diags
+		}
+		names = append(names, userPolicies.names()...)
+	}
+	cannedPolicies, diags := fetchCannedPolicies(ctx, admin, names)
+	if diags != nil {
+		return diags
+	}
+	policies = append(policies, cannedPolicies...)
+
+	conditions, account := policySimulationConditions(d, user)
+	actions := getStringList(d.Get("actions").([]interface{}))
+	resources := getStringList(d.Get("resources").([]interface{}))
+
+	tflog.Debug(ctx, fmt.Sprintf("Simulating %d actions on %d resources against %d policies", len(actions), len(resources), len(policies)))
+
+	results := make([]map[string]interface{}, 0, len(actions)*len(resources))
+	allAllowed := true
+	for _, action := range actions {
+		for _, res := range resources {
+			bucket, object := splitPolicyResource(res)
+			args := policy.Args{
+				AccountName:     account,
+				Action:          policy.Action(action),
+				BucketName:      bucket,
+				ObjectName:      object,
+				ConditionValues: conditions,
+			}
+			decision, policyName, sid := evaluatePolicies(policies, args)
+			allowed := decision == policyDecisionAllowed
+			allAllowed = allAllowed && allowed
+			results = append(results, map[string]interface{}{
+				"action":                action,
+				"resource":              res,
+				"allowed":               allowed,
+				"decision":              decision,
+				"matched_policy":        policyName,
+				"matched_statement_sid": sid,
+			})
+		}
+	}
+
+	d.SetId(strconv.Itoa(HashcodeString(fmt.Sprintf("%v|%v|%v|%v|%s", names, actions, resources, conditions, d.Get("policy_documents")))))
+	if err := d.Set("results", results); err != nil {
+		return NewResourceError("setting results", d.Id(), err)
+	}
+	_ = d.Set("all_allowed", allAllowed)
+
+	return nil
+}
+
+// evaluatePolicies mirrors the MinIO policy engine: an explicit deny in any
+// policy wins, otherwise any allow grants access, otherwise access is
+// implicitly denied. It returns the decision with the deciding statement.
+func evaluatePolicies(policies []namedPolicy, args policy.Args) (decision, policyName, sid string) {
+	for _, p := range policies {
+		for _, statement := range p.policy.Statements {
+			if statement.Effect == policy.Deny && !statement.IsAllowed(args) {
+				return policyDecisionExplicitDeny, p.name, string(statement.SID)
+			}
+		}
+	}
+	for _, p := range policies {
+		for _, statement := range p.policy.Statements {
+			if statement.Effect == policy.Allow && statement.IsAllowed(args) {
+				return policyDecisionAllowed, p.name, string(statement.SID)
+			}
+		}
+	}
+	return policyDecisionImplicitDeny, "", ""
+}
+
+// splitPolicyResource splits an S3 ARN or bucket/key path into its bucket
+// and object name.
+func splitPolicyResource(resource string) (bucket, object string) {
+	resource = strings.TrimPrefix(resource, policy.ResourceARNPrefix)
+	bucket, object, _ = strings.Cut(resource, "/")
+	return bucket, object
+}
+
+func policySimulationConditions(d *schema.ResourceData, user string) (map[string][]string, string) {
+	conditions := map[string][]string{}
+	account := user
+
+	if v, ok := d.GetOk("context"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		c := v.([]interface{})[0].(map[string]interface{})
+		if ip := c["source_ip"].(string); ip != "" {
+			conditions["SourceIp"] = []string{ip}
+		}
+		if prefix := c["prefix"].(string); prefix != "" {
+			conditions["prefix"] = []string{prefix}
+		}
+		if username := c["username"].(string); username != "" {
+			account = username
+		}
+	}
+
+	if account != "" {
+		conditions["username"] = []string{account}
+	}
+
+	return conditions, account
+}
+
+// groupPolicies are the policies a principal inherits from one group.
+type groupPolicies struct {
+	group    string
+	policies []string
+}
+
+// principalPolicies are the policy names attached to a user or LDAP user DN,
+// directly and through group membership.
+type principalPolicies struct {
+	direct []string
+	groups []groupPolicies
+}
+
+// names returns every policy name once, direct policies first.
+func (p *principalPolicies) names() []string {
+	var names []string
+	seen := map[string]bool{}
+	add := func(list []string) {
+		for _, name := range list {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	add(p.direct)
+	for _, g := range p.groups {
+		add(g.policies)
+	}
+	return names
+}
+
+func splitPolicyNames(list string) []string {
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func readPrincipalPolicies(ctx context.Context, admin *madmin.AdminClient, user string) (*principalPolicies, diag.Diagnostics) {
+	result := &principalPolicies{}
+
+	if isLDAPDistinguishedName(user) {
+		entities, err := admin.GetLDAPPolicyEntities(ctx, madmin.PolicyEntitiesQuery{Users: []string{user}})
+		if err != nil {
+			return nil, NewResourceError("reading LDAP policy entities", user, err)
+		}
+		for _, m := range entities.UserMappings {
+			result.direct = append(result.direct, m.Policies...)
+			for _, g := range m.MemberOfMappings {
+				result.groups = append(result.groups, groupPolicies{group: g.Group, policies: g.Policies})
+			}
+		}
+		return result, nil
+	}
+
+	info, err := admin.GetUserInfo(ctx, user)
+	if err != nil {
+		return nil, NewResourceError("reading user", user, err)
+	}
+	result.direct = splitPolicyNames(info.PolicyName)
+	for _, group := range info.MemberOf {
+		desc, err := admin.GetGroupDescription(ctx, group)
+		if err != nil {
+			return nil, NewResourceError("reading group", group, err)
+		}
+		if desc.Status == "disabled" {
+			continue
+		}
+		result.groups = append(result.groups, groupPolicies{group: group, policies: splitPolicyNames(desc.Policy)})
+	}
+
+	return result, nil
+}
+
+func fetchCannedPolicies(ctx context.Context, admin *madmin.AdminClient, names []string) ([]namedPolicy, diag.Diagnostics) {
+	policies := make([]namedPolicy, 0, len(names))
+	for _, name := range names {
+		raw, err := admin.InfoCannedPolicy(ctx, name)
+		if err != nil {
+			return nil, NewResourceError("reading policy", name, err)
+		}
+		p, err := policy.ParseConfig(strings.NewReader(string(raw)))
+		if err != nil {
+			return nil, NewResourceError("parsing policy", name, err)
+		}
+		policies = append(policies, namedPolicy{name: name, document: raw, policy: p})
+	}
+	return policies, nil
+}
@@ -0,0 +1,165 @@
+package minio
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/minio/pkg/v3/policy"
+)
+
+const testPolicySimulationDocument = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadReports",
+      "Effect": "Allow",
+      "Action": ["s3:GetObject"],
+      "Resource": ["arn:aws:s3:::reports/*"]
+    },
+    {
+      "Sid": "ListOwnPrefix",
+      "Effect": "Allow",
+      "Action": ["s3:ListBucket"],
+      "Resource": ["arn:aws:s3:::reports"],
+      "Condition": {"StringLike": {"s3:prefix": ["${aws:username}/*"]}}
+    },
+    {
+      "Sid": "NoSecrets",
+      "Effect": "Deny",
+      "Action": ["s3:*"],
+      "Resource": ["arn:aws:s3:::reports/secret/*"]
+    }
+  ]
+}`
+
+func TestAccDataSourceMinioIAMPolicySimulation_documents(t *testing.T) {
+	dataSourceName := "data.minio_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationDocumentsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statement_sid", "ReadReports"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statement_sid", "NoSecrets"),
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceMinioIAMPolicySimulation_user(t *testing.T) {
+	userName := "tfacc-sim-" + acctest.RandString(6)
+	dataSourceName := "data.minio_iam_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicySimulationUserConfig(userName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_policy", "readonly"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "implicitDeny"),
+				),
+			},
+		},
+	})
+}
+
+func TestEvaluatePolicies(t *testing.T) {
+	p, err := policy.ParseConfig(strings.NewReader(testPolicySimulationDocument))
+	if err != nil {
+		t.Fatalf("parsing policy: %v", err)
+	}
+	policies := []namedPolicy{{name: "reports", policy: p}}
+
+	cases := []struct {
+		action, resource, username, prefix string
+		decision, sid                      string
+	}{
+		{"s3:GetObject", "arn:aws:s3:::reports/2024/q1.csv", "", "", policyDecisionAllowed, "ReadReports"},
+		{"s3:GetObject", "reports/secret/keys.txt", "", "", policyDecisionExplicitDeny, "NoSecrets"},
+		{"s3:PutObject", "arn:aws:s3:::reports/2024/q1.csv", "", "", policyDecisionImplicitDeny, ""},
+		{"s3:ListBucket", "arn:aws:s3:::reports", "alice", "alice/", policyDecisionAllowed, "ListOwnPrefix"},
+		{"s3:ListBucket", "arn:aws:s3:::reports", "alice", "bob/", policyDecisionImplicitDeny, ""},
+	}
+
+	for _, c := range cases {
+		bucket, object := splitPolicyResource(c.resource)
+		conditions := map[string][]string{}
+		if c.username != "" {
+			conditions["username"] = []string{c.username}
+		}
+		if c.prefix != "" {
+			conditions["prefix"] = []string{c.prefix}
+		}
+		decision, policyName, sid := evaluatePolicies(policies, policy.Args{
+			AccountName:     c.username,
+			Action:          policy.Action(c.action),
+			BucketName:      bucket,
+			ObjectName:      object,
+			ConditionValues: conditions,
+		})
+		if decision != c.decision || sid != c.sid {
+			t.Errorf("%s on %s (prefix %q): got %s/%q, want %s/%q", c.action, c.resource, c.prefix, decision, sid, c.decision, c.sid)
+		}
+		if decision != policyDecisionImplicitDeny && policyName != "reports" {
+			t.Errorf("%s on %s: matched policy %q, want reports", c.action, c.resource, policyName)
+		}
+	}
+}
+
+func TestSplitPolicyResource(t *testing.T) {
+	cases := map[string][2]string{
+		"arn:aws:s3:::bucket":         {"bucket", ""},
+		"arn:aws:s3:::bucket/a/b.txt": {"bucket", "a/b.txt"},
+		"bucket/key":                  {"bucket", "key"},
+	}
+	for in, want := range cases {
+		bucket, object := splitPolicyResource(in)
+		if bucket != want[0] || object != want[1] {
+			t.Errorf("splitPolicyResource(%q) = %q, %q, want %q, %q", in, bucket, object, want[0], want[1])
+		}
+	}
+}
+
+func testAccPolicySimulationDocumentsConfig() string {
+	return fmt.Sprintf(`
+data "minio_iam_policy_simulation" "test" {
+  policy_documents = [%q]
+  actions          = ["s3:GetObject"]
+  resources        = ["arn:aws:s3:::reports/2024/q1.csv", "arn:aws:s3:::reports/secret/keys.txt"]
+}
+`, strings.ReplaceAll(testPolicySimulationDocument, "${", "$${"))
+}
+
+func testAccPolicySimulationUserConfig(userName string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "minio_iam_user_policy_attachment" "test" {
+  user_name   = minio_iam_user.test.name
+  policy_name = "readonly"
+}
+
+data "minio_iam_policy_simulation" "test" {
+  user      = minio_iam_user_policy_attachment.test.user_name
+  actions   = ["s3:GetObject", "s3:PutObject"]
+  resources = ["arn:aws:s3:::any-bucket/object"]
+}
+`, userName)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
 )
 
 func dataSourceIAMServiceAccounts() *schema.Resource {
@@ -31,13 +32,13 @@ func dataSourceIAMServiceAccountsRead(d *schema.ResourceData, meta interface{})
 	admin := meta.(*S3MinioClient).S3Admin
 
 	user := d.Get("user").(string)
-	resp, err := admin.ListServiceAccounts(context.Background(), user)
+	accounts, err := listIAMServiceAccounts(context.Background(), admin, user)
 	if err != nil {
 		return err
 	}
 
 	var out []map[string]interface{}
-	for _, sa := range resp.Accounts {
+	for _, sa := range accounts {
 		out = append(out, map[string]interface{}{
 			"access_key": sa.AccessKey,
 		})
@@ -47,3 +48,14 @@ func dataSourceIAMServiceAccountsRead(d *schema.ResourceData, meta interface{})
 	_ = d.Set("service_accounts", out)
 	return nil
 }
+
+// listIAMServiceAccounts returns the service accounts (access keys) of user.
+// It is shared by the minio_iam_service_accounts data source and the
+// minio_iam_service_account and minio_accesskey list resources.
+func listIAMServiceAccounts(ctx context.Context, admin *madmin.AdminClient, user string) ([]madmin.ServiceAccountInfo, error) {
+	resp, err := admin.ListServiceAccounts(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Accounts, nil
+}
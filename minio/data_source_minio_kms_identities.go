@@ -0,0 +1,89 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMinioKMSIdentities() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the client identities known to the KMS (KES) configured on the MinIO server and the policy assigned to each.",
+		ReadContext: dataSourceMinioKMSIdentitiesRead,
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*",
+				Description: "Glob pattern identities must match. Defaults to `*`.",
+			},
+			"identities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Identities matching the pattern.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity of the KMS client.",
+						},
+						"policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Policy assigned to the identity.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the identity was assigned.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity that assigned the policy.",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error reported by the KMS for this identity, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMinioKMSIdentitiesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	pattern := d.Get("pattern").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing KMS identities matching %q", pattern))
+
+	infos, err := admin.ListIdentities(ctx, pattern)
+	if err != nil {
+		return NewResourceError("listing KMS identities", pattern, err)
+	}
+
+	identities := make([]map[string]interface{}, 0, len(infos))
+	for _, info := range infos {
+		identities = append(identities, map[string]interface{}{
+			"identity":   info.Identity,
+			"policy":     info.Policy,
+			"created_at": info.CreatedAt,
+			"created_by": info.CreatedBy,
+			"error":      info.Error,
+		})
+	}
+
+	d.SetId(pattern)
+	if err := d.Set("identities", identities); err != nil {
+		return NewResourceError("setting identities", pattern, err)
+	}
+
+	return nil
+}
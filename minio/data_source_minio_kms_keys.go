@@ -0,0 +1,88 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMinioKMSKeys() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the keys stored in the KMS configured on the MinIO server, optionally filtered by a name pattern.",
+		ReadContext: dataSourceMinioKMSKeysRead,
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*",
+				Description: "Glob pattern keys must match (e.g. `app-*`). Defaults to `*`.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Keys matching the pattern.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key ID.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the key was created.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity that created the key.",
+						},
+					},
+				},
+			},
+			"key_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the keys matching the pattern.",
+			},
+		},
+	}
+}
+
+func dataSourceMinioKMSKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	pattern := d.Get("pattern").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing KMS keys matching %q", pattern))
+
+	infos, err := admin.ListKeys(ctx, pattern)
+	if err != nil {
+		return NewResourceError("listing KMS keys", pattern, err)
+	}
+
+	keys := make([]map[string]interface{}, 0, len(infos))
+	keyIDs := make([]string, 0, len(infos))
+	for _, info := range infos {
+		keys = append(keys, map[string]interface{}{
+			"name":       info.Name,
+			"created_at": info.CreatedAt,
+			"created_by": info.CreatedBy,
+		})
+		keyIDs = append(keyIDs, info.Name)
+	}
+
+	d.SetId(pattern)
+	if err := d.Set("keys", keys); err != nil {
+		return NewResourceError("setting keys", pattern, err)
+	}
+	if err := d.Set("key_ids", keyIDs); err != nil {
+		return NewResourceError("setting key_ids", pattern, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMinioKMSPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the policies on the KMS (KES) configured on the MinIO server, optionally filtered by a name pattern.",
+		ReadContext: dataSourceMinioKMSPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*",
+				Description: "Glob pattern policy names must match. Defaults to `*`.",
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies matching the pattern.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the policy.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the policy was created.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identity that created the policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMinioKMSPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	pattern := d.Get("pattern").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing KMS policies matching %q", pattern))
+
+	infos, err := admin.ListPolicies(ctx, pattern)
+	if err != nil {
+		return NewResourceError("listing KMS policies", pattern, err)
+	}
+
+	policies := make([]map[string]interface{}, 0, len(infos))
+	for _, info := range infos {
+		policies = append(policies, map[string]interface{}{
+			"name":       info.Name,
+			"created_at": info.CreatedAt,
+			"created_by": info.CreatedBy,
+		})
+	}
+
+	d.SetId(pattern)
+	if err := d.Set("policies", policies); err != nil {
+		return NewResourceError("setting policies", pattern, err)
+	}
+
+	return nil
+}
@@ -1,9 +1,11 @@
 package minio
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -14,6 +16,15 @@ func TestDataSourceMinioKMS_schemas(t *testing.T) {
 	if err := dataSourceMinioKMSMetrics().InternalValidate(nil, false); err != nil {
 		t.Fatalf("minio_kms_metrics schema invalid: %v", err)
 	}
+	if err := dataSourceMinioKMSKeys().InternalValidate(nil, false); err != nil {
+		t.Fatalf("minio_kms_keys schema invalid: %v", err)
+	}
+	if err := dataSourceMinioKMSPolicies().InternalValidate(nil, false); err != nil {
+		t.Fatalf("minio_kms_policies schema invalid: %v", err)
+	}
+	if err := dataSourceMinioKMSIdentities().InternalValidate(nil, false); err != nil {
+		t.Fatalf("minio_kms_identities schema invalid: %v", err)
+	}
 }
 
 func testAccPreCheckKMS(t *testing.T) {
@@ -64,3 +75,30 @@ func TestAccDataSourceMinioKMSMetrics_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceMinioKMSKeys_basic(t *testing.T) {
+	keyID := fmt.Sprintf("tfacc-kms-keys-%d", acctest.RandInt())
+	const name = "data.minio_kms_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckKMSKey(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioKMSKeyConfig(keyID) + fmt.Sprintf(`
+data "minio_kms_keys" "test" {
+  provider = kmsminio
+  pattern  = "%s*"
+
+  depends_on = [minio_kms_key.test]
+}
+`, keyID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "keys.#", "1"),
+					resource.TestCheckResourceAttr(name, "keys.0.name", keyID),
+					resource.TestCheckResourceAttr(name, "key_ids.0", keyID),
+				),
+			},
+		},
+	})
+}
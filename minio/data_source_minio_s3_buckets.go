@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/minio-go/v7"
 )
 
 func dataSourceMinioS3Buckets() *schema.Resource {
@@ -32,18 +33,14 @@ func dataSourceMinioS3Buckets() *schema.Resource {
 func dataSourceMinioS3BucketsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*S3MinioClient).S3Client
 
-	buckets, err := client.ListBuckets(context.Background())
+	prefix := strings.TrimSpace(d.Get("name_prefix").(string))
+	buckets, err := listBucketsWithPrefix(context.Background(), client, prefix)
 	if err != nil {
 		return err
 	}
 
-	prefix := strings.TrimSpace(d.Get("name_prefix").(string))
-
 	var out []map[string]interface{}
 	for _, b := range buckets {
-		if prefix != "" && !strings.HasPrefix(b.Name, prefix) {
-			continue
-		}
 		out = append(out, map[string]interface{}{
 			"name":          b.Name,
 			"creation_date": b.CreationDate.Format(time.RFC3339),
@@ -54,3 +51,22 @@ func dataSourceMinioS3BucketsRead(d *schema.ResourceData, meta interface{}) erro
 	_ = d.Set("buckets", out)
 	return nil
 }
+
+// listBucketsWithPrefix returns the buckets whose name starts with prefix, or
+// all buckets when prefix is empty. It is shared by the minio_s3_buckets data
+// source and the minio_s3_bucket list resource.
+func listBucketsWithPrefix(ctx context.Context, client *minio.Client, prefix string) ([]minio.BucketInfo, error) {
+	buckets, err := client.ListBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []minio.BucketInfo
+	for _, b := range buckets {
+		if prefix != "" && !strings.HasPrefix(b.Name, prefix) {
+			continue
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
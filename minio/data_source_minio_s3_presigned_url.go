@@ -0,0 +1,140 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMinioS3PresignedURL() *schema.Resource {
+	return &schema.Resource{
+		Description: "Generates a presigned URL (GET, PUT, HEAD) or POST policy for an object. " +
+			"The URL is stored in state and regenerated on every read; use the `minio_s3_presigned_url` ephemeral resource when the URL must stay secret.",
+		ReadContext: dataSourceMinioS3PresignedURLRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the bucket.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object key. Required except for POST policies using `key_prefix`.",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key prefix uploads are restricted to (POST only).",
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validation.StringInSlice(presignedURLMethods, false),
+				Description:  "`GET`, `PUT`, `HEAD` or `POST`. Defaults to `GET`.",
+			},
+			"expires_in": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      presignedURLDefaultExpiry,
+				ValidateFunc: validation.IntBetween(1, presignedURLMaxExpiry),
+				Description:  "Validity in seconds, at most 7 days. Defaults to 3600.",
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object version (GET and HEAD only).",
+			},
+			"response_headers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Response header overrides (GET and HEAD only). Keys are `cache-control`, `content-disposition`, " +
+					"`content-encoding`, `content-language`, `content-type` and `expires`.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Content type the upload must use (PUT and POST only).",
+			},
+			"content_length_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum upload size in bytes (POST only). Without `content_length_max`, the upload size is not limited above.",
+			},
+			"content_length_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum upload size in bytes (POST only).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Presigned URL. For POST policies, the URL to post the form to.",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration time of the URL (RFC3339).",
+			},
+			"form_fields": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Form fields to send along with the file (POST only).",
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Headers the request must carry because they are part of the signature.",
+			},
+		},
+	}
+}
+
+func dataSourceMinioS3PresignedURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*S3MinioClient).S3Client
+
+	responseHeaders := map[string]string{}
+	for name, value := range d.Get("response_headers").(map[string]interface{}) {
+		responseHeaders[name] = value.(string)
+	}
+
+	req := presignedURLRequest{
+		method:           d.Get("method").(string),
+		bucket:           d.Get("bucket").(string),
+		key:              d.Get("key").(string),
+		keyPrefix:        d.Get("key_prefix").(string),
+		versionID:        d.Get("version_id").(string),
+		contentType:      d.Get("content_type").(string),
+		expiresIn:        int64(d.Get("expires_in").(int)),
+		responseHeaders:  responseHeaders,
+		contentLengthMin: int64(d.Get("content_length_min").(int)),
+		contentLengthMax: int64(d.Get("content_length_max").(int)),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Presigning %s URL for %s/%s%s", req.method, req.bucket, req.key, req.keyPrefix))
+
+	result, err := presignURL(ctx, client, req)
+	if err != nil {
+		return NewResourceError("presigning URL", req.bucket, err)
+	}
+
+	d.SetId(strconv.Itoa(HashcodeString(fmt.Sprintf("%s|%s|%s|%s|%s", req.method, req.bucket, req.key, req.keyPrefix, req.versionID))))
+	_ = d.Set("url", result.url)
+	_ = d.Set("expiration", result.expiration.Format(time.RFC3339))
+	_ = d.Set("form_fields", result.formFields)
+	_ = d.Set("headers", result.headers)
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package minio
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestAccDataSourceMinioS3PresignedURL_get(t *testing.T) {
+	bucketName := "tfacc-presign-" + acctest.RandString(6)
+	dataSourceName := "data.minio_s3_presigned_url.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLConfig(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "expiration"),
+					resource.TestCheckResourceAttrWith(dataSourceName, "url", func(value string) error {
+						resp, err := http.Get(value)
+						if err != nil {
+							return err
+						}
+						defer resp.Body.Close()
+						body, err := io.ReadAll(resp.Body)
+						if err != nil {
+							return err
+						}
+						if resp.StatusCode != http.StatusOK || string(body) != "presigned" {
+							return fmt.Errorf("GET %s returned %d %q, want 200 %q", value, resp.StatusCode, body, "presigned")
+						}
+						if got := resp.Header.Get("Content-Disposition"); got != "attachment" {
+							return fmt.Errorf("Content-Disposition is %q, want attachment", got)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestPresignedURLRequestValidate(t *testing.T) {
+	valid := []presignedURLRequest{
+		{method: "GET", bucket: "b", key: "k", expiresIn: 60, versionID: "v1", responseHeaders: map[string]string{"Content-Disposition": "attachment"}},
+		{method: "PUT", bucket: "b", key: "k", expiresIn: 60, contentType: "text/plain"},
+		{method: "POST", bucket: "b", keyPrefix: "uploads/", expiresIn: 60, contentLengthMax: 1024},
+		{method: "POST", bucket: "b", keyPrefix: "uploads/", expiresIn: 60, contentLengthMin: 1024},
+	}
+	for _, r := range valid {
+		if err := r.validate(); err != nil {
+			t.Errorf("validate(%+v) = %v, want nil", r, err)
+		}
+	}
+
+	invalid := map[string]presignedURLRequest{
+		"key is required":                  {method: "GET", bucket: "b", expiresIn: 60},
+		"only one of key and key_prefix":   {method: "POST", bucket: "b", key: "k", keyPrefix: "p", expiresIn: 60},
+		"key_prefix is only supported":     {method: "PUT", bucket: "b", keyPrefix: "p", expiresIn: 60},
+		"response_headers is only":         {method: "PUT", bucket: "b", key: "k", expiresIn: 60, responseHeaders: map[string]string{"content-type": "x"}},
+		"content_type is only supported":   {method: "HEAD", bucket: "b", key: "k", expiresIn: 60, contentType: "x"},
+		"expires_in must be between":       {method: "GET", bucket: "b", key: "k", expiresIn: presignedURLMaxExpiry + 1},
+		"invalid content length range":     {method: "POST", bucket: "b", key: "k", expiresIn: 60, contentLengthMin: 10, contentLengthMax: 5},
+		"unsupported response header":      {method: "GET", bucket: "b", key: "k", expiresIn: 60, responseHeaders: map[string]string{"x-amz-meta": "x"}},
+		"content_length_min and content_l": {method: "GET", bucket: "b", key: "k", expiresIn: 60, contentLengthMax: 5},
+	}
+	for want, r := range invalid {
+		if err := r.validate(); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("validate(%+v) = %v, want error containing %q", r, err, want)
+		}
+	}
+}
+
+func TestPresignURL(t *testing.T) {
+	client, err := minio.New("127.0.0.1:9000", &minio.Options{
+		Creds:  credentials.NewStaticV4("minio", "minio123", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := presignURL(context.Background(), client, presignedURLRequest{
+		method:          "GET",
+		bucket:          "reports",
+		key:             "2024/q1.csv",
+		versionID:       "v1",
+		expiresIn:       600,
+		responseHeaders: map[string]string{"Content-Disposition": "attachment"},
+	})
+	if err != nil {
+		t.Fatalf("presigning GET: %v", err)
+	}
+	u, err := url.Parse(result.url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Path != "/reports/2024/q1.csv" {
+		t.Errorf("path = %q, want /reports/2024/q1.csv", u.Path)
+	}
+	query := u.Query()
+	if query.Get("versionId") != "v1" || query.Get("response-content-disposition") != "attachment" || query.Get("X-Amz-Expires") != "600" {
+		t.Errorf("unexpected query %v", query)
+	}
+
+	result, err = presignURL(context.Background(), client, presignedURLRequest{
+		method:      "PUT",
+		bucket:      "reports",
+		key:         "upload.csv",
+		expiresIn:   600,
+		contentType: "text/csv",
+	})
+	if err != nil {
+		t.Fatalf("presigning PUT: %v", err)
+	}
+	if result.headers["Content-Type"] != "text/csv" || !strings.Contains(result.url, "content-type") {
+		t.Errorf("PUT URL %s with headers %v is not signed with the content type", result.url, result.headers)
+	}
+
+	result, err = presignURL(context.Background(), client, presignedURLRequest{
+		method:           "POST",
+		bucket:           "reports",
+		keyPrefix:        "uploads/",
+		expiresIn:        600,
+		contentLengthMax: 1024,
+	})
+	if err != nil {
+		t.Fatalf("presigning POST: %v", err)
+	}
+	if result.formFields["policy"] == "" || result.formFields["x-amz-signature"] == "" {
+		t.Errorf("POST form fields %v lack the policy or signature", result.formFields)
+	}
+
+	result, err = presignURL(context.Background(), client, presignedURLRequest{
+		method:           "POST",
+		bucket:           "reports",
+		keyPrefix:        "uploads/",
+		expiresIn:        600,
+		contentLengthMin: 1024,
+	})
+	if err != nil {
+		t.Fatalf("presigning POST with a minimum length: %v", err)
+	}
+	postPolicy, err := base64.StdEncoding.DecodeString(result.formFields["policy"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := fmt.Sprintf(`["content-length-range", 1024, %d]`, int64(math.MaxInt64)); !strings.Contains(string(postPolicy), want) {
+		t.Errorf("POST policy %s lacks the condition %s", postPolicy, want)
+	}
+}
+
+func testAccPresignedURLConfig(bucketName string) string {
+	return fmt.Sprintf(`
+resource "minio_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "minio_s3_object" "test" {
+  bucket_name = minio_s3_bucket.test.bucket
+  object_name = "report.txt"
+  content     = "presigned"
+}
+
+data "minio_s3_presigned_url" "test" {
+  bucket     = minio_s3_object.test.bucket_name
+  key        = minio_s3_object.test.object_name
+  expires_in = 300
+
+  response_headers = {
+    "content-disposition" = "attachment"
+  }
+}
+`, bucketName)
+}
@@ -0,0 +1,104 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The provider-level default_tags are merged into the tags of the resources
+// that manage bucket and object tags. Such a resource keeps the configured
+// tags in "tags" and the tags actually written to the server, defaults
+// included, in the computed "tags_all".
+
+// tagsAllSchema returns the schema of the computed tags_all attribute.
+func tagsAllSchema(target string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: fmt.Sprintf("Map of all tags assigned to the %s, including those inherited from the provider `default_tags` block.", target),
+	}
+}
+
+// expandDefaultTags reads the tags of the provider default_tags block.
+func expandDefaultTags(d *schema.ResourceData) map[string]string {
+	blocks, ok := d.Get("default_tags").([]interface{})
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	block := blocks[0].(map[string]interface{})
+	tags := convertToStringMap(block["tags"])
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// providerDefaultTags returns the default tags configured on the provider.
+func providerDefaultTags(meta interface{}) map[string]string {
+	client, ok := meta.(*S3MinioClient)
+	if !ok || client == nil {
+		return nil
+	}
+	return client.DefaultTags
+}
+
+// mergeDefaultTags returns the default tags overridden by the resource tags.
+func mergeDefaultTags(defaults, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(tags))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// withoutDefaultTags returns the tags read from the server without those
+// that match a default tag, unless the tag is also configured on the
+// resource, so that the defaults do not show up as drift of "tags".
+func withoutDefaultTags(remote, defaults, configured map[string]string) map[string]string {
+	tags := make(map[string]string, len(remote))
+	for k, v := range remote {
+		if _, ok := configured[k]; !ok {
+			if dv, ok := defaults[k]; ok && dv == v {
+				continue
+			}
+		}
+		tags[k] = v
+	}
+	return tags
+}
+
+// resourceTagsAll returns the tags to write to the server: the configured
+// tags merged over the provider default tags.
+func resourceTagsAll(d *schema.ResourceData, meta interface{}) map[string]string {
+	return mergeDefaultTags(providerDefaultTags(meta), convertToStringMap(d.Get("tags")))
+}
+
+// setResourceTags stores the tags read from the server in "tags_all", and
+// in "tags" without the provider default tags.
+func setResourceTags(d *schema.ResourceData, meta interface{}, remote map[string]string) error {
+	configured := convertToStringMap(d.Get("tags"))
+	if err := d.Set("tags", withoutDefaultTags(remote, providerDefaultTags(meta), configured)); err != nil {
+		return err
+	}
+	return d.Set("tags_all", remote)
+}
+
+// customizeDiffTagsAll plans "tags_all" from the configured tags and the
+// provider default tags, so that a change of the defaults updates the
+// resource.
+func customizeDiffTagsAll(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tagsAll := mergeDefaultTags(providerDefaultTags(meta), convertToStringMap(d.Get("tags")))
+	tflog.Debug(ctx, fmt.Sprintf("Planning tags_all with %d tags", len(tagsAll)))
+	return d.SetNew("tags_all", tagsAll)
+}
@@ -0,0 +1,119 @@
+package minio
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandDefaultTags(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  map[string]interface{}
+		want map[string]string
+	}{
+		{name: "unset", raw: map[string]interface{}{}, want: nil},
+		{
+			name: "empty",
+			raw:  map[string]interface{}{"default_tags": []interface{}{map[string]interface{}{}}},
+			want: nil,
+		},
+		{
+			name: "tags",
+			raw: map[string]interface{}{"default_tags": []interface{}{map[string]interface{}{
+				"tags": map[string]interface{}{"cost-center": "42", "owner": "platform"},
+			}}},
+			want: map[string]string{"cost-center": "42", "owner": "platform"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+			if got := expandDefaultTags(d); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expandDefaultTags() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaults := map[string]string{"owner": "platform", "env": "dev"}
+	tags := map[string]string{"env": "prod", "app": "web"}
+
+	want := map[string]string{"owner": "platform", "env": "prod", "app": "web"}
+	if got := mergeDefaultTags(defaults, tags); !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeDefaultTags() = %v, want %v", got, want)
+	}
+	if got := mergeDefaultTags(nil, nil); len(got) != 0 {
+		t.Errorf("mergeDefaultTags(nil, nil) = %v, want an empty map", got)
+	}
+	if defaults["env"] != "dev" {
+		t.Error("mergeDefaultTags() modified the default tags")
+	}
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	defaults := map[string]string{"owner": "platform", "cost-center": "42"}
+
+	cases := []struct {
+		name       string
+		remote     map[string]string
+		configured map[string]string
+		want       map[string]string
+	}{
+		{
+			name:   "defaults are hidden",
+			remote: map[string]string{"owner": "platform", "cost-center": "42", "app": "web"},
+			want:   map[string]string{"app": "web"},
+		},
+		{
+			name:       "configured default is kept",
+			remote:     map[string]string{"owner": "platform", "cost-center": "42"},
+			configured: map[string]string{"owner": "platform"},
+			want:       map[string]string{"owner": "platform"},
+		},
+		{
+			name:   "overridden default is kept",
+			remote: map[string]string{"owner": "data", "cost-center": "42"},
+			want:   map[string]string{"owner": "data"},
+		},
+		{
+			name:   "no tags",
+			remote: map[string]string{},
+			want:   map[string]string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := withoutDefaultTags(tc.remote, defaults, tc.configured); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("withoutDefaultTags() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSetResourceTags(t *testing.T) {
+	meta := &S3MinioClient{DefaultTags: map[string]string{"owner": "platform"}}
+	d := schema.TestResourceDataRaw(t, resourceMinioBucketTags().Schema, map[string]interface{}{
+		"bucket": "test-bucket",
+		"tags":   map[string]interface{}{"env": "prod"},
+	})
+
+	if got := resourceTagsAll(d, meta); !reflect.DeepEqual(got, map[string]string{"owner": "platform", "env": "prod"}) {
+		t.Errorf("resourceTagsAll() = %v", got)
+	}
+
+	remote := map[string]string{"owner": "platform", "env": "prod", "drift": "yes"}
+	if err := setResourceTags(d, meta, remote); err != nil {
+		t.Fatalf("setResourceTags() error = %v", err)
+	}
+	if got := convertToStringMap(d.Get("tags")); !reflect.DeepEqual(got, map[string]string{"env": "prod", "drift": "yes"}) {
+		t.Errorf("tags = %v, want the remote tags without the defaults", got)
+	}
+	if got := convertToStringMap(d.Get("tags_all")); !reflect.DeepEqual(got, remote) {
+		t.Errorf("tags_all = %v, want the remote tags", got)
+	}
+}
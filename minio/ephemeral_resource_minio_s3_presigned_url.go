@@ -0,0 +1,217 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &ephemeralMinioS3PresignedURL{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &ephemeralMinioS3PresignedURL{}
+)
+
+type ephemeralMinioS3PresignedURL struct {
+	client *S3MinioClient
+}
+
+type presignedURLModel struct {
+	Bucket           types.String `tfsdk:"bucket"`
+	Key              types.String `tfsdk:"key"`
+	KeyPrefix        types.String `tfsdk:"key_prefix"`
+	Method           types.String `tfsdk:"method"`
+	ExpiresIn        types.Int64  `tfsdk:"expires_in"`
+	VersionID        types.String `tfsdk:"version_id"`
+	ResponseHeaders  types.Map    `tfsdk:"response_headers"`
+	ContentType      types.String `tfsdk:"content_type"`
+	ContentLengthMin types.Int64  `tfsdk:"content_length_min"`
+	ContentLengthMax types.Int64  `tfsdk:"content_length_max"`
+	URL              types.String `tfsdk:"url"`
+	Expiration       types.String `tfsdk:"expiration"`
+	FormFields       types.Map    `tfsdk:"form_fields"`
+	Headers          types.Map    `tfsdk:"headers"`
+}
+
+// request converts the model into a presignedURLRequest, applying the
+// defaults the ephemeral schema cannot declare. It reports false while any
+// argument is still unknown.
+func (m presignedURLModel) request(ctx context.Context, diags *diag.Diagnostics) (presignedURLRequest, bool) {
+	for _, v := range []interface{ IsUnknown() bool }{
+		m.Bucket, m.Key, m.KeyPrefix, m.Method, m.ExpiresIn, m.VersionID,
+		m.ResponseHeaders, m.ContentType, m.ContentLengthMin, m.ContentLengthMax,
+	} {
+		if v.IsUnknown() {
+			return presignedURLRequest{}, false
+		}
+	}
+
+	req := presignedURLRequest{
+		method:           http.MethodGet,
+		bucket:           m.Bucket.ValueString(),
+		key:              m.Key.ValueString(),
+		keyPrefix:        m.KeyPrefix.ValueString(),
+		versionID:        m.VersionID.ValueString(),
+		contentType:      m.ContentType.ValueString(),
+		expiresIn:        presignedURLDefaultExpiry,
+		responseHeaders:  map[string]string{},
+		contentLengthMin: m.ContentLengthMin.ValueInt64(),
+		contentLengthMax: m.ContentLengthMax.ValueInt64(),
+	}
+	if !m.Method.IsNull() {
+		req.method = m.Method.ValueString()
+	}
+	if !m.ExpiresIn.IsNull() {
+		req.expiresIn = m.ExpiresIn.ValueInt64()
+	}
+	if !m.ResponseHeaders.IsNull() {
+		diags.Append(m.ResponseHeaders.ElementsAs(ctx, &req.responseHeaders, false)...)
+	}
+
+	return req, !diags.HasError()
+}
+
+func newEphemeralMinioS3PresignedURL() ephemeral.EphemeralResource {
+	return &ephemeralMinioS3PresignedURL{}
+}
+
+func (e *ephemeralMinioS3PresignedURL) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_presigned_url"
+}
+
+func (e *ephemeralMinioS3PresignedURL) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a presigned URL (GET, PUT, HEAD) or POST policy for an object without storing it in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the bucket.",
+			},
+			"key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Object key. Required except for POST policies using `key_prefix`.",
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Key prefix uploads are restricted to (POST only).",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Description: "`GET`, `PUT`, `HEAD` or `POST`. Defaults to `GET`.",
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Validity in seconds, at most 7 days. Defaults to 3600.",
+			},
+			"version_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Object version (GET and HEAD only).",
+			},
+			"response_headers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Response header overrides (GET and HEAD only). Keys are `cache-control`, `content-disposition`, " +
+					"`content-encoding`, `content-language`, `content-type` and `expires`.",
+			},
+			"content_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content type the upload must use (PUT and POST only).",
+			},
+			"content_length_min": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum upload size in bytes (POST only). Without `content_length_max`, the upload size is not limited above.",
+			},
+			"content_length_max": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum upload size in bytes (POST only).",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Presigned URL. For POST policies, the URL to post the form to.",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration time of the URL (RFC3339).",
+			},
+			"form_fields": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Form fields to send along with the file (POST only).",
+			},
+			"headers": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Headers the request must carry because they are part of the signature.",
+			},
+		},
+	}
+}
+
+func (e *ephemeralMinioS3PresignedURL) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (e *ephemeralMinioS3PresignedURL) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data presignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	presign, known := data.request(ctx, &resp.Diagnostics)
+	if !known {
+		return
+	}
+	if err := presign.validate(); err != nil {
+		resp.Diagnostics.AddError("Invalid presigned URL configuration", err.Error())
+	}
+}
+
+func (e *ephemeralMinioS3PresignedURL) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The MinIO provider must be configured before presigning URLs.")
+		return
+	}
+
+	var data presignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	presign, _ := data.request(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Presigning %s URL for %s/%s%s", presign.method, presign.bucket, presign.key, presign.keyPrefix))
+
+	result, err := presignURL(ctx, e.client.S3Client, presign)
+	if err != nil {
+		resp.Diagnostics.AddError("Error presigning URL", err.Error())
+		return
+	}
+
+	formFields, diags := types.MapValueFrom(ctx, types.StringType, result.formFields)
+	resp.Diagnostics.Append(diags...)
+	headers, diags := types.MapValueFrom(ctx, types.StringType, result.headers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.URL = types.StringValue(result.url)
+	data.Expiration = types.StringValue(result.expiration.Format(time.RFC3339))
+	data.FormFields = formFields
+	data.Headers = headers
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+package minio
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Ephemeral values never reach the state, so the URL is checked by
+// configuring a second provider instance with the endpoint it points to and
+// reading the bucket through it. regex() and timecmp() fail the run when url
+// or expiration is missing or malformed.
+func TestAccEphemeralMinioS3PresignedURL_basic(t *testing.T) {
+	bucketName := "tfacc-presign-" + acctest.RandString(6)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralPresignedURLConfig(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.minio_s3_bucket.presigned", "bucket", bucketName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEphemeralMinioS3PresignedURL_invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+ephemeral "minio_s3_presigned_url" "test" {
+  bucket     = "bucket"
+  key        = "key"
+  method     = "PUT"
+  version_id = "v1"
+}
+`,
+				ExpectError: regexp.MustCompile(`version_id is only supported for GET and HEAD URLs`),
+			},
+		},
+	})
+}
+
+func testAccEphemeralPresignedURLConfig(bucketName string) string {
+	return fmt.Sprintf(`
+resource "minio_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+ephemeral "minio_s3_presigned_url" "test" {
+  bucket     = %[1]q
+  key        = "report.txt"
+  expires_in = 300
+}
+
+provider "minio" {
+  alias     = "presigned"
+  minio_ssl = startswith(ephemeral.minio_s3_presigned_url.test.url, "https://")
+  minio_server = (
+    timecmp(ephemeral.minio_s3_presigned_url.test.expiration, plantimestamp()) > 0
+    ? regex("^https?://([^/]+)/%[1]s/report\\.txt\\?.*X-Amz-Signature=", ephemeral.minio_s3_presigned_url.test.url)[0]
+    : "expired.invalid:9000"
+  )
+}
+
+data "minio_s3_bucket" "presigned" {
+  provider = minio.presigned
+  bucket   = minio_s3_bucket.test.bucket
+}
+`, bucketName)
+}
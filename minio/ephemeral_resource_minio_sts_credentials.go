@@ -0,0 +1,153 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	stsCredentialsDefaultDuration = 3600
+	stsCredentialsMinDuration     = 900
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &ephemeralMinioSTSCredentials{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &ephemeralMinioSTSCredentials{}
+)
+
+type ephemeralMinioSTSCredentials struct {
+	client *S3MinioClient
+}
+
+type stsCredentialsModel struct {
+	Policy          types.String `tfsdk:"policy"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	AccessKey       types.String `tfsdk:"access_key"`
+	SecretKey       types.String `tfsdk:"secret_key"`
+	SessionToken    types.String `tfsdk:"session_token"`
+	Expiration      types.String `tfsdk:"expiration"`
+}
+
+func newEphemeralMinioSTSCredentials() ephemeral.EphemeralResource {
+	return &ephemeralMinioSTSCredentials{}
+}
+
+func (e *ephemeralMinioSTSCredentials) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sts_credentials"
+}
+
+func (e *ephemeralMinioSTSCredentials) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtains short-lived credentials from the MinIO STS AssumeRole API using the provider's credentials. " +
+			"The credentials are never stored in the plan or state; pass them to write-only arguments or other ephemeral contexts.",
+		Attributes: map[string]schema.Attribute{
+			"policy": schema.StringAttribute{
+				Optional:    true,
+				Description: "Inline session policy (JSON) further restricting the permissions of the credentials.",
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Validity of the credentials in seconds. At least %d, defaults to %d.", stsCredentialsMinDuration, stsCredentialsDefaultDuration),
+			},
+			"access_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Temporary access key.",
+			},
+			"secret_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Temporary secret key.",
+			},
+			"session_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Session token to send along with the temporary access key.",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration time of the credentials (RFC3339).",
+			},
+		},
+	}
+}
+
+func (e *ephemeralMinioSTSCredentials) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (e *ephemeralMinioSTSCredentials) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data stsCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Policy.IsNull() && !data.Policy.IsUnknown() {
+		if _, errs := validateIAMPolicyJSON(data.Policy.ValueString(), "policy"); len(errs) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("policy"), "Invalid session policy", errs[0].Error())
+		}
+	}
+	if !data.DurationSeconds.IsNull() && !data.DurationSeconds.IsUnknown() && data.DurationSeconds.ValueInt64() < stsCredentialsMinDuration {
+		resp.Diagnostics.AddAttributeError(path.Root("duration_seconds"), "Invalid duration",
+			fmt.Sprintf("duration_seconds must be at least %d, got %d", stsCredentialsMinDuration, data.DurationSeconds.ValueInt64()))
+	}
+}
+
+func (e *ephemeralMinioSTSCredentials) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The MinIO provider must be configured before requesting STS credentials.")
+		return
+	}
+
+	var data stsCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	duration := stsCredentialsDefaultDuration
+	if !data.DurationSeconds.IsNull() {
+		duration = int(data.DurationSeconds.ValueInt64())
+	}
+
+	creds, err := e.client.S3Client.GetCreds()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading provider credentials", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Requesting STS credentials valid for %ds", duration))
+
+	// An empty STSEndpoint makes the request go to the client's own endpoint
+	// and HTTP client, so TLS settings and custom CAs apply.
+	sts := &credentials.STSAssumeRole{
+		Options: credentials.STSAssumeRoleOptions{
+			AccessKey:       creds.AccessKeyID,
+			SecretKey:       creds.SecretAccessKey,
+			SessionToken:    creds.SessionToken,
+			Policy:          data.Policy.ValueString(),
+			DurationSeconds: duration,
+			Location:        e.client.S3Region,
+		},
+	}
+	value, err := sts.RetrieveWithCredContext(e.client.S3Client.CredContext())
+	if err != nil {
+		resp.Diagnostics.AddError("Error assuming role", err.Error())
+		return
+	}
+
+	data.AccessKey = types.StringValue(value.AccessKeyID)
+	data.SecretKey = types.StringValue(value.SecretAccessKey)
+	data.SessionToken = types.StringValue(value.SessionToken)
+	data.Expiration = types.StringValue(value.Expiration.UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
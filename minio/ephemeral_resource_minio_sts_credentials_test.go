@@ -0,0 +1,78 @@
+package minio
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Ephemeral values never reach the state, so the credentials are checked by
+// configuring a second provider instance with them and reading through it.
+func TestAccEphemeralMinioSTSCredentials_basic(t *testing.T) {
+	bucketName := "tfacc-sts-" + acctest.RandString(6)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralSTSCredentialsConfig(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.minio_s3_bucket.sts", "bucket", bucketName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEphemeralMinioSTSCredentials_invalidDuration(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+ephemeral "minio_sts_credentials" "test" {
+  duration_seconds = 60
+}
+`,
+				ExpectError: regexp.MustCompile(`duration_seconds must be at least 900`),
+			},
+		},
+	})
+}
+
+func testAccEphemeralSTSCredentialsConfig(bucketName string) string {
+	return fmt.Sprintf(`
+resource "minio_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+ephemeral "minio_sts_credentials" "test" {
+  duration_seconds = 900
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:ListBucket", "s3:GetBucketLocation"]
+      Resource = ["arn:aws:s3:::%[1]s"]
+    }]
+  })
+}
+
+provider "minio" {
+  alias               = "sts"
+  minio_user          = ephemeral.minio_sts_credentials.test.access_key
+  minio_password      = ephemeral.minio_sts_credentials.test.secret_key
+  minio_session_token = ephemeral.minio_sts_credentials.test.session_token
+}
+
+data "minio_s3_bucket" "sts" {
+  provider = minio.sts
+  bucket   = minio_s3_bucket.test.bucket
+}
+`, bucketName)
+}
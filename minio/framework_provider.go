@@ -0,0 +1,138 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
+	_ provider.ProviderWithActions            = &frameworkProvider{}
+)
+
+// frameworkProvider serves the features only available through
+// terraform-plugin-framework (ephemeral resources, functions, list resources,
+// actions). It is muxed with the SDKv2 provider it wraps: its schema is
+// derived from the SDKv2 provider schema, and it hands out the
+// *S3MinioClient the SDKv2 provider was configured with.
+type frameworkProvider struct {
+	sdk *schema.Provider
+}
+
+// NewFrameworkProvider returns the framework provider paired with the given
+// SDKv2 provider.
+func NewFrameworkProvider(sdk *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdk: sdk}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "minio"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = frameworkProviderSchema(p.sdk.Schema)
+}
+
+// Configure relies on the mux server configuring its servers in order: the
+// SDKv2 server comes first, so its client is already built when this runs.
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.sdk.Meta().(*S3MinioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The MinIO client was not created by the SDKv2 provider before the framework provider was configured. "+
+				"This is a bug in the provider, please report it.",
+		)
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
+	resp.ActionData = client
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralMinioSTSCredentials,
+		newEphemeralMinioS3PresignedURL,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		newBucketARNFunction,
+		newParseARNFunction,
+		newCanonicalPolicyFunction,
+		newPoliciesEquivalentFunction,
+		newParseSizeFunction,
+		newReplicationTargetARNFunction,
+	}
+}
+
+// ListResources lists instances of SDKv2 managed resources, see
+// sdkListResource.
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	constructors := []func(*schema.Provider) list.ListResource{
+		newListMinioS3Bucket,
+		newListMinioIAMUser,
+		newListMinioIAMGroup,
+		newListMinioIAMPolicy,
+		newListMinioIAMServiceAccount,
+		newListMinioAccessKey,
+	}
+
+	listResources := make([]func() list.ListResource, 0, len(constructors))
+	for _, constructor := range constructors {
+		listResources = append(listResources, func() list.ListResource { return constructor(p.sdk) })
+	}
+	return listResources
+}
+
+// Actions replace the resources that only ran an operation on create, such
+// as minio_s3_incomplete_upload_cleanup.
+func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		newActionMinioServiceRestart,
+		newActionMinioIncompleteUploadCleanup,
+		newActionMinioBucketMetadataImport,
+		newActionMinioConfigRestore,
+	}
+}
+
+// frameworkClient extracts the client from the provider data handed to a
+// framework resource's Configure method. The provider data is nil until the
+// provider has been configured, in which case nil is returned silently.
+func frameworkClient(providerData any, diags *diag.Diagnostics) *S3MinioClient {
+	if providerData == nil {
+		return nil
+	}
+	client, ok := providerData.(*S3MinioClient)
+	if !ok {
+		diags.AddError("Unexpected provider data", fmt.Sprintf("Expected *S3MinioClient, got %T. This is a bug in the provider, please report it.", providerData))
+		return nil
+	}
+	return client
+}
@@ -0,0 +1,100 @@
+package minio
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	pschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProviderSchema converts the SDKv2 provider schema into the
+// equivalent framework provider schema. The mux server requires both
+// providers to report identical provider schemas, so the framework side is
+// always generated from the SDKv2 definition rather than maintained by hand.
+func frameworkProviderSchema(sdk map[string]*schema.Schema) pschema.Schema {
+	attributes, blocks := frameworkProviderAttributes(sdk)
+	return pschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+func frameworkProviderAttributes(sdk map[string]*schema.Schema) (map[string]pschema.Attribute, map[string]pschema.Block) {
+	attributes := map[string]pschema.Attribute{}
+	blocks := map[string]pschema.Block{}
+
+	names := make([]string, 0, len(sdk))
+	for name := range sdk {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s := sdk[name]
+		if r, ok := s.Elem.(*schema.Resource); ok {
+			nestedAttributes, nestedBlocks := frameworkProviderAttributes(r.Schema)
+			object := pschema.NestedBlockObject{Attributes: nestedAttributes, Blocks: nestedBlocks}
+			if s.Type == schema.TypeSet {
+				blocks[name] = pschema.SetNestedBlock{NestedObject: object, Description: s.Description, DeprecationMessage: s.Deprecated}
+			} else {
+				blocks[name] = pschema.ListNestedBlock{NestedObject: object, Description: s.Description, DeprecationMessage: s.Deprecated}
+			}
+			continue
+		}
+		attributes[name] = frameworkProviderAttribute(s)
+	}
+
+	return attributes, blocks
+}
+
+func frameworkProviderAttribute(s *schema.Schema) pschema.Attribute {
+	// Mirror the SDKv2 behavior of reporting a required attribute as optional
+	// when its DefaultFunc (usually an environment variable) supplies a value.
+	required, optional := s.Required, s.Optional
+	if required && s.DefaultFunc != nil {
+		if v, err := s.DefaultFunc(); err != nil || v != nil {
+			required, optional = false, true
+		}
+	}
+
+	switch s.Type {
+	case schema.TypeString:
+		return pschema.StringAttribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+	case schema.TypeBool:
+		return pschema.BoolAttribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+	case schema.TypeInt:
+		return pschema.Int64Attribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+	case schema.TypeFloat:
+		return pschema.Float64Attribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+	case schema.TypeList:
+		return pschema.ListAttribute{ElementType: frameworkElementType(s), Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+	case schema.TypeSet:
+		return pschema.SetAttribute{ElementType: frameworkElementType(s), Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+	case schema.TypeMap:
+		return pschema.MapAttribute{ElementType: frameworkElementType(s), Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+	default:
+		panic(fmt.Sprintf("unsupported provider schema type %s", s.Type))
+	}
+}
+
+// frameworkElementType returns the element type of a primitive collection.
+// SDKv2 maps without Elem hold strings.
+func frameworkElementType(s *schema.Schema) attr.Type {
+	elem, ok := s.Elem.(*schema.Schema)
+	if !ok {
+		return types.StringType
+	}
+	switch elem.Type {
+	case schema.TypeBool:
+		return types.BoolType
+	case schema.TypeInt:
+		return types.Int64Type
+	case schema.TypeFloat:
+		return types.Float64Type
+	default:
+		return types.StringType
+	}
+}
@@ -0,0 +1,47 @@
+package minio
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &bucketARNFunction{}
+
+type bucketARNFunction struct{}
+
+func newBucketARNFunction() function.Function {
+	return &bucketARNFunction{}
+}
+
+func (f *bucketARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bucket_arn"
+}
+
+func (f *bucketARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the ARN of a bucket",
+		Description: "Returns the ARN (`arn:aws:s3:::<bucket>`) used to reference a bucket in IAM and bucket policies.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "bucket",
+				Description: "Name of the bucket.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *bucketARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var bucket string
+	resp.Error = req.Arguments.Get(ctx, &bucket)
+	if resp.Error != nil {
+		return
+	}
+	if bucket == "" {
+		resp.Error = function.NewArgumentFuncError(0, "bucket must not be empty")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, awsResourcePrefix+bucket)
+}
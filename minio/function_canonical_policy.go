@@ -0,0 +1,50 @@
+package minio
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &canonicalPolicyFunction{}
+
+type canonicalPolicyFunction struct{}
+
+func newCanonicalPolicyFunction() function.Function {
+	return &canonicalPolicyFunction{}
+}
+
+func (f *canonicalPolicyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "canonical_policy"
+}
+
+func (f *canonicalPolicyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a policy document",
+		Description: "Returns the policy JSON normalized the same way the provider normalizes policies it stores in state: " +
+			"compact, with object keys sorted.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "policy",
+				Description: "Policy document (JSON).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *canonicalPolicyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy string
+	resp.Error = req.Arguments.Get(ctx, &policy)
+	if resp.Error != nil {
+		return
+	}
+
+	canonical, err := NormalizeAndCompareJSONPolicies("", policy)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid policy JSON: "+err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, canonical)
+}
@@ -0,0 +1,87 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseARNFunction{}
+
+type parseARNFunction struct{}
+
+// parsedARN holds the components of an ARN. S3 ARNs leave region and
+// account_id empty; MinIO replication target ARNs carry the target ID as
+// account_id and the target bucket as resource.
+type parsedARN struct {
+	Partition string `tfsdk:"partition"`
+	Service   string `tfsdk:"service"`
+	Region    string `tfsdk:"region"`
+	AccountID string `tfsdk:"account_id"`
+	Resource  string `tfsdk:"resource"`
+}
+
+var parsedARNAttributeTypes = map[string]attr.Type{
+	"partition":  types.StringType,
+	"service":    types.StringType,
+	"region":     types.StringType,
+	"account_id": types.StringType,
+	"resource":   types.StringType,
+}
+
+func newParseARNFunction() function.Function {
+	return &parseARNFunction{}
+}
+
+func (f *parseARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_arn"
+}
+
+func (f *parseARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses an ARN into its components",
+		Description: "Splits an ARN (`arn:<partition>:<service>:<region>:<account_id>:<resource>`), such as an S3 ARN " +
+			"or a MinIO replication target ARN, into an object with `partition`, `service`, `region`, `account_id` and `resource`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "arn",
+				Description: "ARN to parse.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: parsedARNAttributeTypes},
+	}
+}
+
+func (f *parseARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arn string
+	resp.Error = req.Arguments.Get(ctx, &arn)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseARN(arn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, parsed)
+}
+
+func parseARN(arn string) (parsedARN, error) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[1] == "" || parts[2] == "" || parts[5] == "" {
+		return parsedARN{}, fmt.Errorf("%q is not a valid ARN, expected arn:<partition>:<service>:<region>:<account_id>:<resource>", arn)
+	}
+	return parsedARN{
+		Partition: parts[1],
+		Service:   parts[2],
+		Region:    parts[3],
+		AccountID: parts[4],
+		Resource:  parts[5],
+	}, nil
+}
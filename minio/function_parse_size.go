@@ -0,0 +1,57 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &parseSizeFunction{}
+
+type parseSizeFunction struct{}
+
+func newParseSizeFunction() function.Function {
+	return &parseSizeFunction{}
+}
+
+func (f *parseSizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_size"
+}
+
+func (f *parseSizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a human-readable size into bytes",
+		Description: "Converts sizes such as `10GiB`, `500MB` or `1.5 TB` into a number of bytes, using the same parsing " +
+			"as the provider's size arguments (e.g. `bandwidth_limit`). SI suffixes are powers of 1000, IEC suffixes (`KiB`, `MiB`, ...) powers of 1024.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "size",
+				Description: "Size to parse.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *parseSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+	resp.Error = req.Arguments.Get(ctx, &size)
+	if resp.Error != nil {
+		return
+	}
+
+	bytes, err := humanize.ParseBytes(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid size %q: %s", size, err))
+		return
+	}
+	if bytes > math.MaxInt64 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("size %q exceeds the largest supported value", size))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, int64(bytes))
+}
@@ -0,0 +1,55 @@
+package minio
+
+import (
+	"context"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &policiesEquivalentFunction{}
+
+type policiesEquivalentFunction struct{}
+
+func newPoliciesEquivalentFunction() function.Function {
+	return &policiesEquivalentFunction{}
+}
+
+func (f *policiesEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policies_equivalent"
+}
+
+func (f *policiesEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compares two policy documents",
+		Description: "Returns whether two policy documents are semantically equivalent, ignoring formatting, " +
+			"statement and value ordering, and single values written as one-element lists.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "policy_a",
+				Description: "First policy document (JSON).",
+			},
+			function.StringParameter{
+				Name:        "policy_b",
+				Description: "Second policy document (JSON).",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *policiesEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policyA, policyB string
+	resp.Error = req.Arguments.Get(ctx, &policyA, &policyB)
+	if resp.Error != nil {
+		return
+	}
+
+	equivalent, err := awspolicy.PoliciesAreEquivalent(policyA, policyB)
+	if err != nil {
+		resp.Error = function.NewFuncError("comparing policies: " + err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, equivalent)
+}
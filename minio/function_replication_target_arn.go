@@ -0,0 +1,61 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &replicationTargetARNFunction{}
+
+type replicationTargetARNFunction struct{}
+
+func newReplicationTargetARNFunction() function.Function {
+	return &replicationTargetARNFunction{}
+}
+
+func (f *replicationTargetARNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "replication_target_arn"
+}
+
+func (f *replicationTargetARNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the ARN of a replication remote target",
+		Description: "Returns the ARN MinIO assigns to a bucket replication remote target: " +
+			"`arn:minio:replication:<region>:<target_id>:<target_bucket>`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "region",
+				Description: "Region of the remote target. May be empty.",
+			},
+			function.StringParameter{
+				Name:        "target_id",
+				Description: "ID of the remote target.",
+			},
+			function.StringParameter{
+				Name:        "target_bucket",
+				Description: "Name of the bucket on the remote target.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *replicationTargetARNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region, targetID, targetBucket string
+	resp.Error = req.Arguments.Get(ctx, &region, &targetID, &targetBucket)
+	if resp.Error != nil {
+		return
+	}
+	if targetID == "" {
+		resp.Error = function.NewArgumentFuncError(1, "target_id must not be empty")
+		return
+	}
+	if targetBucket == "" {
+		resp.Error = function.NewArgumentFuncError(2, "target_bucket must not be empty")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, fmt.Sprintf("arn:minio:replication:%s:%s:%s", region, targetID, targetBucket))
+}
@@ -0,0 +1,194 @@
+package minio
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestFunctionBucketARN(t *testing.T) {
+	got, err := runFunction(t, newBucketARNFunction(), types.StringUnknown(), types.StringValue("reports"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := types.StringValue("arn:aws:s3:::reports"); !got.Equal(want) {
+		t.Errorf("bucket_arn = %s, want %s", got, want)
+	}
+
+	if _, err := runFunction(t, newBucketARNFunction(), types.StringUnknown(), types.StringValue("")); err == nil {
+		t.Error("bucket_arn(\"\") succeeded, want error")
+	}
+}
+
+func TestFunctionParseARN(t *testing.T) {
+	cases := map[string]parsedARN{
+		"arn:aws:s3:::reports/*": {Partition: "aws", Service: "s3", Resource: "reports/*"},
+		"arn:minio:replication:us-east-1:c5be6b16-6b1d-4de8-9d3b-f4b4f6bd7c61:dest": {
+			Partition: "minio", Service: "replication", Region: "us-east-1", AccountID: "c5be6b16-6b1d-4de8-9d3b-f4b4f6bd7c61", Resource: "dest",
+		},
+		"arn:minio:sqs::primary:webhook": {Partition: "minio", Service: "sqs", AccountID: "primary", Resource: "webhook"},
+	}
+	for arn, want := range cases {
+		got, err := parseARN(arn)
+		if err != nil {
+			t.Errorf("parseARN(%q) returned %v", arn, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("parseARN(%q) = %+v, want %+v", arn, got, want)
+		}
+	}
+
+	for _, arn := range []string{"", "reports", "arn:aws:s3", "urn:aws:s3:::reports", "arn:aws:s3:::"} {
+		if _, err := parseARN(arn); err == nil {
+			t.Errorf("parseARN(%q) succeeded, want error", arn)
+		}
+	}
+
+	got, funcErr := runFunction(t, newParseARNFunction(), types.ObjectUnknown(parsedARNAttributeTypes), types.StringValue("arn:aws:s3:::reports"))
+	if funcErr != nil {
+		t.Fatal(funcErr)
+	}
+	if resource := got.(types.Object).Attributes()["resource"]; !resource.Equal(types.StringValue("reports")) {
+		t.Errorf("parse_arn resource = %s, want reports", resource)
+	}
+}
+
+func TestFunctionCanonicalPolicy(t *testing.T) {
+	policy := `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": ["arn:aws:s3:::reports/*"]}]
+}`
+	got, err := runFunction(t, newCanonicalPolicyFunction(), types.StringUnknown(), types.StringValue(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := types.StringValue(`{"Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Resource":["arn:aws:s3:::reports/*"]}],"Version":"2012-10-17"}`)
+	if !got.Equal(want) {
+		t.Errorf("canonical_policy = %s, want %s", got, want)
+	}
+
+	if _, err := runFunction(t, newCanonicalPolicyFunction(), types.StringUnknown(), types.StringValue("{")); err == nil {
+		t.Error("canonical_policy of invalid JSON succeeded, want error")
+	}
+}
+
+func TestFunctionPoliciesEquivalent(t *testing.T) {
+	a := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::reports/*"}]}`
+	b := `{"Statement":[{"Resource":["arn:aws:s3:::reports/*"],"Action":["s3:GetObject"],"Effect":"Allow"}],"Version":"2012-10-17"}`
+	c := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::reports/*"}]}`
+
+	for _, tc := range []struct {
+		a, b string
+		want bool
+	}{
+		{a, b, true},
+		{a, c, false},
+	} {
+		got, err := runFunction(t, newPoliciesEquivalentFunction(), types.BoolUnknown(), types.StringValue(tc.a), types.StringValue(tc.b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(types.BoolValue(tc.want)) {
+			t.Errorf("policies_equivalent(%s, %s) = %s, want %t", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestFunctionParseSize(t *testing.T) {
+	cases := map[string]int64{
+		"10GiB":  10 << 30,
+		"500MB":  500_000_000,
+		"1.5 TB": 1_500_000_000_000,
+		"1024":   1024,
+	}
+	for size, want := range cases {
+		got, err := runFunction(t, newParseSizeFunction(), types.Int64Unknown(), types.StringValue(size))
+		if err != nil {
+			t.Errorf("parse_size(%q) returned %v", size, err)
+			continue
+		}
+		if !got.Equal(types.Int64Value(want)) {
+			t.Errorf("parse_size(%q) = %s, want %d", size, got, want)
+		}
+	}
+
+	for _, size := range []string{"", "ten gigs", "10EiB"} {
+		if _, err := runFunction(t, newParseSizeFunction(), types.Int64Unknown(), types.StringValue(size)); err == nil {
+			t.Errorf("parse_size(%q) succeeded, want error", size)
+		}
+	}
+}
+
+func TestFunctionReplicationTargetARN(t *testing.T) {
+	got, err := runFunction(t, newReplicationTargetARNFunction(), types.StringUnknown(),
+		types.StringValue("us-east-1"), types.StringValue("c5be6b16"), types.StringValue("dest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := types.StringValue("arn:minio:replication:us-east-1:c5be6b16:dest"); !got.Equal(want) {
+		t.Errorf("replication_target_arn = %s, want %s", got, want)
+	}
+
+	_, err = runFunction(t, newReplicationTargetARNFunction(), types.StringUnknown(),
+		types.StringValue(""), types.StringValue(""), types.StringValue("dest"))
+	if err == nil || !strings.Contains(err.Error(), "target_id") {
+		t.Errorf("replication_target_arn without target_id returned %v, want target_id error", err)
+	}
+}
+
+func TestAccProviderFunctions(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "bucket_arn" {
+  value = provider::minio::bucket_arn("reports")
+}
+
+output "arn_service" {
+  value = provider::minio::parse_arn("arn:minio:replication:us-east-1:c5be6b16:dest").service
+}
+
+output "equivalent" {
+  value = provider::minio::policies_equivalent(
+    jsonencode({ Version = "2012-10-17", Statement = [{ Effect = "Allow", Action = "s3:GetObject", Resource = "arn:aws:s3:::reports/*" }] }),
+    provider::minio::canonical_policy(jsonencode({ Version = "2012-10-17", Statement = [{ Effect = "Allow", Action = ["s3:GetObject"], Resource = ["arn:aws:s3:::reports/*"] }] })),
+  )
+}
+
+output "size" {
+  value = provider::minio::parse_size("10GiB")
+}
+
+output "target_arn" {
+  value = provider::minio::replication_target_arn("us-east-1", "c5be6b16", "dest")
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("bucket_arn", "arn:aws:s3:::reports"),
+					resource.TestCheckOutput("arn_service", "replication"),
+					resource.TestCheckOutput("equivalent", "true"),
+					resource.TestCheckOutput("size", "10737418240"),
+					resource.TestCheckOutput("target_arn", "arn:minio:replication:us-east-1:c5be6b16:dest"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package minio
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// stringIdentity returns a resource identity made of a single string
+// attribute holding the resource ID. Resources using it import with
+// schema.ImportStatePassthroughWithIdentity and call setStringIdentity from
+// their read function.
+func stringIdentity(attribute, description string) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				attribute: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       description,
+				},
+			}
+		},
+	}
+}
+
+// setStringIdentity records the resource ID in the identity attribute
+// declared by stringIdentity.
+func setStringIdentity(d *schema.ResourceData, attribute string) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	return identity.Set(attribute, d.Id())
+}
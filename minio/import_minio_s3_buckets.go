@@ -13,6 +13,10 @@ func resourceMinioS3BucketImportState(
 	d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
 
+	if _, err := schema.ImportStatePassthroughWithIdentity("bucket")(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	if diag := minioReadBucket(ctx, d, meta); diag.HasError() {
 		return nil, fmt.Errorf("could not read minio bucket")
 	}
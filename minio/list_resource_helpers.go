@@ -0,0 +1,141 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sdkListResource is embedded by the list resources of managed resources
+// implemented with SDKv2. The framework does not know those resources, so
+// the list resource reports their schema and identity schema as served by
+// the SDKv2 half of the mux server. The managed resources use stringIdentity,
+// which holds the resource ID.
+type sdkListResource struct {
+	sdk *schema.Provider
+	// typeSuffix is the resource type name without the provider prefix,
+	// e.g. "_s3_bucket".
+	typeSuffix string
+	// identityAttribute is the attribute declared by stringIdentity.
+	identityAttribute string
+	client            *S3MinioClient
+}
+
+// sdkListItem is a listed resource instance.
+type sdkListItem struct {
+	id          string
+	displayName string
+	// attributes are set, along with id, on the resource returned when
+	// include_resource is requested: the arguments needed to write its
+	// configuration. The other attributes are read when it is imported.
+	attributes map[string]string
+}
+
+func (r *sdkListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeSuffix
+}
+
+func (r *sdkListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *sdkListResource) RawV6Schemas(ctx context.Context, req list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	typeName := "minio" + r.typeSuffix
+
+	resourceSchema, identitySchema, err := sdkResourceProtoV6Schemas(ctx, r.sdk, typeName)
+	if err != nil {
+		// The framework reports the missing schemas as an error.
+		tflog.Error(ctx, fmt.Sprintf("Reading the schemas of %s: %s", typeName, err))
+		return
+	}
+
+	resp.ProtoV6Schema = resourceSchema
+	resp.ProtoV6IdentitySchema = identitySchema
+}
+
+// configured reports an error diagnostic on the stream when the provider has
+// not been configured yet.
+func (r *sdkListResource) configured(stream *list.ListResultsStream) bool {
+	if r.client != nil {
+		return true
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Provider not configured", "The MinIO provider must be configured before listing resources.")
+	stream.Results = list.ListResultsStreamDiagnostics(diags)
+	return false
+}
+
+// results yields a list result per item, stopping at the requested limit.
+func (r *sdkListResource) results(ctx context.Context, req list.ListRequest, items []sdkListItem) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			if !push(r.result(ctx, req, item)) {
+				return
+			}
+		}
+	}
+}
+
+func (r *sdkListResource) result(ctx context.Context, req list.ListRequest, item sdkListItem) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.displayName
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(r.identityAttribute), item.id)...)
+
+	if req.IncludeResource {
+		result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), item.id)...)
+		for name, value := range item.attributes {
+			result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), value)...)
+		}
+	}
+
+	return result
+}
+
+// sdkResourceProtoV6Schemas returns the schema and identity schema of a SDKv2
+// managed resource in protocol v6, as served by the mux server.
+func sdkResourceProtoV6Schemas(ctx context.Context, sdk *schema.Provider, typeName string) (*tfprotov6.Schema, *tfprotov6.ResourceIdentitySchema, error) {
+	server, err := tf5to6server.UpgradeServer(ctx, sdk.GRPCProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+	identities, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resourceSchema, ok := schemas.ResourceSchemas[typeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("resource %s is not defined", typeName)
+	}
+	identitySchema, ok := identities.IdentitySchemas[typeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("resource %s has no identity", typeName)
+	}
+
+	return resourceSchema, identitySchema, nil
+}
+
+func listErrorDiagnostics(summary string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError(summary, err.Error())
+	return diags
+}
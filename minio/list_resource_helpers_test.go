@@ -0,0 +1,113 @@
+package minio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	identityschema "github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListResources_rawV6Schemas(t *testing.T) {
+	ctx := context.Background()
+	p := NewFrameworkProvider(Provider()).(*frameworkProvider)
+	identityAttributes := map[string]string{
+		"minio_s3_bucket":           "bucket",
+		"minio_iam_user":            "name",
+		"minio_iam_group":           "name",
+		"minio_iam_policy":          "name",
+		"minio_iam_service_account": "access_key",
+		"minio_accesskey":           "access_key",
+	}
+
+	for _, newListResource := range p.ListResources(ctx) {
+		listResource := newListResource()
+
+		var metadata resource.MetadataResponse
+		listResource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "minio"}, &metadata)
+
+		var schemas list.RawV6SchemaResponse
+		listResource.(list.ListResourceWithRawV6Schemas).RawV6Schemas(ctx, list.RawV6SchemaRequest{}, &schemas)
+		if schemas.ProtoV6Schema == nil || schemas.ProtoV6IdentitySchema == nil {
+			t.Errorf("%s: missing schemas %+v", metadata.TypeName, schemas)
+			continue
+		}
+
+		identityAttribute := identityAttributes[metadata.TypeName]
+		attributes := schemas.ProtoV6IdentitySchema.IdentityAttributes
+		if len(attributes) != 1 || attributes[0].Name != identityAttribute || !attributes[0].RequiredForImport {
+			t.Errorf("%s: identity attributes %+v, want %s required for import", metadata.TypeName, attributes, identityAttribute)
+		}
+	}
+}
+
+func TestSDKListResourceResults(t *testing.T) {
+	ctx := context.Background()
+	r := &sdkListResource{typeSuffix: "_test", identityAttribute: "name"}
+
+	req := list.ListRequest{
+		Limit: 2,
+		ResourceSchema: resourceschema.Schema{
+			Attributes: map[string]resourceschema.Attribute{
+				"id":   resourceschema.StringAttribute{Computed: true},
+				"name": resourceschema.StringAttribute{Required: true},
+				"tags": resourceschema.MapAttribute{Optional: true, ElementType: types.StringType},
+			},
+			Blocks: map[string]resourceschema.Block{
+				"rule": resourceschema.ListNestedBlock{
+					NestedObject: resourceschema.NestedBlockObject{
+						Attributes: map[string]resourceschema.Attribute{
+							"prefix": resourceschema.StringAttribute{Optional: true},
+						},
+					},
+				},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"name": identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}
+	items := []sdkListItem{
+		{id: "a", displayName: "A", attributes: map[string]string{"name": "a"}},
+		{id: "b", displayName: "B", attributes: map[string]string{"name": "b"}},
+		{id: "c", displayName: "C", attributes: map[string]string{"name": "c"}},
+	}
+
+	for _, includeResource := range []bool{false, true} {
+		req.IncludeResource = includeResource
+
+		var names []string
+		for result := range r.results(ctx, req, items) {
+			if result.Diagnostics.HasError() {
+				t.Fatalf("result %s: %v", result.DisplayName, result.Diagnostics)
+			}
+
+			var name types.String
+			result.Identity.GetAttribute(ctx, path.Root("name"), &name)
+			names = append(names, name.ValueString())
+
+			var id, resourceName types.String
+			var tags types.Map
+			result.Resource.GetAttribute(ctx, path.Root("id"), &id)
+			result.Resource.GetAttribute(ctx, path.Root("name"), &resourceName)
+			result.Resource.GetAttribute(ctx, path.Root("tags"), &tags)
+			if includeResource {
+				if id.ValueString() != name.ValueString() || resourceName.ValueString() != name.ValueString() || !tags.IsNull() {
+					t.Errorf("include_resource: resource id=%s name=%s tags=%s, want %s and null tags", id, resourceName, tags, name)
+				}
+			} else if !result.Resource.Raw.IsNull() {
+				t.Errorf("resource %s is set without include_resource", result.Resource.Raw)
+			}
+		}
+
+		if len(names) != 2 || names[0] != "a" || names[1] != "b" {
+			t.Errorf("listed %v, want [a b] (limited to 2)", names)
+		}
+	}
+}
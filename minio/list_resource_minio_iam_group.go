@@ -0,0 +1,70 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = &listMinioIAMGroup{}
+	_ list.ListResourceWithRawV6Schemas = &listMinioIAMGroup{}
+)
+
+type listMinioIAMGroup struct {
+	sdkListResource
+}
+
+type listMinioIAMGroupModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func newListMinioIAMGroup(sdk *sdkschema.Provider) list.ListResource {
+	return &listMinioIAMGroup{sdkListResource{sdk: sdk, typeSuffix: "_iam_group", identityAttribute: "name"}}
+}
+
+func (r *listMinioIAMGroup) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists IAM groups, optionally filtered by name prefix.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list groups whose name starts with this prefix.",
+			},
+		},
+	}
+}
+
+func (r *listMinioIAMGroup) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listMinioIAMGroupModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if !r.configured(stream) {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing IAM groups with prefix [%s]", config.NamePrefix.ValueString()))
+
+	groupNames, err := listIAMGroupNames(ctx, r.client.S3Admin, config.NamePrefix.ValueString())
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(listErrorDiagnostics("Error listing IAM groups", err))
+		return
+	}
+
+	items := make([]sdkListItem, 0, len(groupNames))
+	for _, name := range groupNames {
+		items = append(items, sdkListItem{
+			id:          name,
+			displayName: name,
+			attributes:  map[string]string{"name": name},
+		})
+	}
+	stream.Results = r.results(ctx, req, items)
+}
@@ -0,0 +1,98 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = &listMinioIAMPolicy{}
+	_ list.ListResourceWithRawV6Schemas = &listMinioIAMPolicy{}
+)
+
+// builtinIAMPolicies are the canned policies every MinIO deployment ships
+// with. They cannot be deleted, so they are not listed.
+var builtinIAMPolicies = []string{"consoleAdmin", "diagnostics", "readonly", "readwrite", "writeonly"}
+
+type listMinioIAMPolicy struct {
+	sdkListResource
+}
+
+type listMinioIAMPolicyModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func newListMinioIAMPolicy(sdk *sdkschema.Provider) list.ListResource {
+	return &listMinioIAMPolicy{sdkListResource{sdk: sdk, typeSuffix: "_iam_policy", identityAttribute: "name"}}
+}
+
+func (r *listMinioIAMPolicy) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists IAM policies, optionally filtered by name prefix. Built-in policies are not listed.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list policies whose name starts with this prefix.",
+			},
+		},
+	}
+}
+
+func (r *listMinioIAMPolicy) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listMinioIAMPolicyModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if !r.configured(stream) {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing IAM policies with prefix [%s]", config.NamePrefix.ValueString()))
+
+	names, err := listIAMPolicyNames(ctx, r.client.S3Admin, config.NamePrefix.ValueString())
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(listErrorDiagnostics("Error listing IAM policies", err))
+		return
+	}
+
+	items := make([]sdkListItem, 0, len(names))
+	for _, name := range names {
+		items = append(items, sdkListItem{
+			id:          name,
+			displayName: name,
+			attributes:  map[string]string{"name": name},
+		})
+	}
+	stream.Results = r.results(ctx, req, items)
+}
+
+// listIAMPolicyNames returns the names of the policies starting with prefix,
+// or of all policies when prefix is empty, sorted and without the built-in
+// policies.
+func listIAMPolicyNames(ctx context.Context, admin *madmin.AdminClient, prefix string) ([]string, error) {
+	policies, err := admin.ListCannedPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []string
+	for name := range policies {
+		if slices.Contains(builtinIAMPolicies, name) || (prefix != "" && !strings.HasPrefix(name, prefix)) {
+			continue
+		}
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out, nil
+}
@@ -0,0 +1,107 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = &listMinioIAMServiceAccount{}
+	_ list.ListResourceWithRawV6Schemas = &listMinioIAMServiceAccount{}
+)
+
+// listMinioIAMServiceAccount lists service accounts for both
+// minio_iam_service_account and minio_accesskey, which manage the same kind
+// of object. userAttribute is the argument holding the parent user.
+type listMinioIAMServiceAccount struct {
+	sdkListResource
+	userAttribute string
+}
+
+type listMinioIAMServiceAccountModel struct {
+	User types.String `tfsdk:"user"`
+}
+
+func newListMinioIAMServiceAccount(sdk *sdkschema.Provider) list.ListResource {
+	return &listMinioIAMServiceAccount{
+		sdkListResource: sdkListResource{sdk: sdk, typeSuffix: "_iam_service_account", identityAttribute: "access_key"},
+		userAttribute:   "target_user",
+	}
+}
+
+func newListMinioAccessKey(sdk *sdkschema.Provider) list.ListResource {
+	return &listMinioIAMServiceAccount{
+		sdkListResource: sdkListResource{sdk: sdk, typeSuffix: "_accesskey", identityAttribute: "access_key"},
+		userAttribute:   "user",
+	}
+}
+
+func (r *listMinioIAMServiceAccount) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the service accounts (access keys) of a user, or of every IAM user.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Optional:    true,
+				Description: "User whose service accounts are listed. Defaults to every IAM user.",
+			},
+		},
+	}
+}
+
+func (r *listMinioIAMServiceAccount) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listMinioIAMServiceAccountModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if !r.configured(stream) {
+		return
+	}
+
+	users := []string{config.User.ValueString()}
+	if config.User.IsNull() {
+		summaries, err := listIAMUsers(ctx, r.client.S3Admin, "", "all")
+		if err != nil {
+			stream.Results = list.ListResultsStreamDiagnostics(listErrorDiagnostics("Error listing IAM users", err))
+			return
+		}
+		users = users[:0]
+		for _, summary := range summaries {
+			users = append(users, summary.name)
+		}
+	}
+
+	var items []sdkListItem
+	for _, user := range users {
+		tflog.Debug(ctx, fmt.Sprintf("Listing service accounts of [%s]", user))
+
+		accounts, err := listIAMServiceAccounts(ctx, r.client.S3Admin, user)
+		if err != nil {
+			stream.Results = list.ListResultsStreamDiagnostics(listErrorDiagnostics(fmt.Sprintf("Error listing service accounts of %s", user), err))
+			return
+		}
+		for _, account := range accounts {
+			items = append(items, serviceAccountListItem(account, user, r.userAttribute))
+		}
+	}
+	stream.Results = r.results(ctx, req, items)
+}
+
+func serviceAccountListItem(account madmin.ServiceAccountInfo, user, userAttribute string) sdkListItem {
+	displayName := fmt.Sprintf("%s (%s)", account.AccessKey, user)
+	if account.Name != "" {
+		displayName = fmt.Sprintf("%s: %s (%s)", account.Name, account.AccessKey, user)
+	}
+	return sdkListItem{
+		id:          account.AccessKey,
+		displayName: displayName,
+		attributes:  map[string]string{userAttribute: user},
+	}
+}
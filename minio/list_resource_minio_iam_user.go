@@ -0,0 +1,100 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ list.ListResourceWithConfigure      = &listMinioIAMUser{}
+	_ list.ListResourceWithRawV6Schemas   = &listMinioIAMUser{}
+	_ list.ListResourceWithValidateConfig = &listMinioIAMUser{}
+)
+
+var listIAMUserStatuses = []string{"enabled", "disabled", "all"}
+
+type listMinioIAMUser struct {
+	sdkListResource
+}
+
+type listMinioIAMUserModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (m listMinioIAMUserModel) status() string {
+	if m.Status.IsNull() {
+		return "all"
+	}
+	return m.Status.ValueString()
+}
+
+func newListMinioIAMUser(sdk *sdkschema.Provider) list.ListResource {
+	return &listMinioIAMUser{sdkListResource{sdk: sdk, typeSuffix: "_iam_user", identityAttribute: "name"}}
+}
+
+func (r *listMinioIAMUser) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists IAM users, optionally filtered by name prefix and status.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list users whose name starts with this prefix.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "`enabled`, `disabled` or `all`. Defaults to `all`.",
+			},
+		},
+	}
+}
+
+func (r *listMinioIAMUser) ValidateListResourceConfig(ctx context.Context, req list.ValidateConfigRequest, resp *list.ValidateConfigResponse) {
+	var config listMinioIAMUserModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Status.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(listIAMUserStatuses, config.status()) {
+		resp.Diagnostics.AddAttributeError(path.Root("status"), "Invalid status",
+			fmt.Sprintf("status must be one of enabled, disabled or all, got %q", config.status()))
+	}
+}
+
+func (r *listMinioIAMUser) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listMinioIAMUserModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if !r.configured(stream) {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing %s IAM users with prefix [%s]", config.status(), config.NamePrefix.ValueString()))
+
+	users, err := listIAMUsers(ctx, r.client.S3Admin, config.NamePrefix.ValueString(), config.status())
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(listErrorDiagnostics("Error listing IAM users", err))
+		return
+	}
+
+	items := make([]sdkListItem, 0, len(users))
+	for _, user := range users {
+		items = append(items, sdkListItem{
+			id:          user.name,
+			displayName: fmt.Sprintf("%s (%s)", user.name, user.status),
+			attributes:  map[string]string{"name": user.name},
+		})
+	}
+	stream.Results = r.results(ctx, req, items)
+}
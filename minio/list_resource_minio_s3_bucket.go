@@ -0,0 +1,70 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = &listMinioS3Bucket{}
+	_ list.ListResourceWithRawV6Schemas = &listMinioS3Bucket{}
+)
+
+type listMinioS3Bucket struct {
+	sdkListResource
+}
+
+type listMinioS3BucketModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func newListMinioS3Bucket(sdk *sdkschema.Provider) list.ListResource {
+	return &listMinioS3Bucket{sdkListResource{sdk: sdk, typeSuffix: "_s3_bucket", identityAttribute: "bucket"}}
+}
+
+func (r *listMinioS3Bucket) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists buckets, optionally filtered by name prefix.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list buckets whose name starts with this prefix.",
+			},
+		},
+	}
+}
+
+func (r *listMinioS3Bucket) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listMinioS3BucketModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if !r.configured(stream) {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listing buckets with prefix [%s]", config.NamePrefix.ValueString()))
+
+	buckets, err := listBucketsWithPrefix(ctx, r.client.S3Client, config.NamePrefix.ValueString())
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(listErrorDiagnostics("Error listing buckets", err))
+		return
+	}
+
+	items := make([]sdkListItem, 0, len(buckets))
+	for _, bucket := range buckets {
+		items = append(items, sdkListItem{
+			id:          bucket.Name,
+			displayName: bucket.Name,
+			attributes:  map[string]string{"bucket": bucket.Name},
+		})
+	}
+	stream.Results = r.results(ctx, req, items)
+}
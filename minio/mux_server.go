@@ -0,0 +1,40 @@
+package minio
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// ProtoV6ProviderServerFactory returns the protocol v6 server combining the
+// SDKv2 provider (upgraded from protocol v5) with the framework provider.
+func ProtoV6ProviderServerFactory(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	return newProtoV6ProviderServerFactory(ctx)
+}
+
+func newProtoV6ProviderServerFactory(ctx context.Context, envVarPrefix ...string) (func() tfprotov6.ProviderServer, error) {
+	sdk := newProvider(envVarPrefix...)
+
+	upgraded, err := tf5to6server.UpgradeServer(ctx, sdk.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// The SDKv2 server must stay first: the mux server configures servers in
+	// order, and the framework provider reuses the client the SDKv2 provider
+	// built.
+	servers := []func() tfprotov6.ProviderServer{
+		func() tfprotov6.ProviderServer { return upgraded },
+		providerserver.NewProtocol6(NewFrameworkProvider(sdk)),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
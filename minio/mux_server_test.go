@@ -0,0 +1,51 @@
+package minio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// The mux server rejects providers whose schemas differ, so the framework
+// provider schema must match the SDKv2 one whether or not the environment
+// supplies the required minio_server.
+func TestProtoV6ProviderServer_schemasMatch(t *testing.T) {
+	for _, endpoint := range []string{"", "127.0.0.1:9000"} {
+		t.Setenv("MINIO_ENDPOINT", endpoint)
+
+		factory, err := ProtoV6ProviderServerFactory(context.Background())
+		if err != nil {
+			t.Fatalf("creating the mux server: %s", err)
+		}
+
+		resp, err := factory().GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+		if err != nil {
+			t.Fatalf("reading the provider schema: %s", err)
+		}
+		for _, d := range resp.Diagnostics {
+			t.Errorf("MINIO_ENDPOINT=%q: %s: %s", endpoint, d.Summary, d.Detail)
+		}
+		if resp.Provider == nil {
+			t.Fatal("mux server returned no provider schema")
+		}
+	}
+}
+
+func TestProtoV6ProviderFactories_freshInstancePerCall(t *testing.T) {
+	for name, factory := range testAccProtoV6ProviderFactories {
+		first, err := factory()
+		if err != nil {
+			t.Fatalf("calling the %q factory: %s", name, err)
+		}
+
+		second, err := factory()
+		if err != nil {
+			t.Fatalf("calling the %q factory: %s", name, err)
+		}
+
+		if first == second {
+			t.Fatalf("the %q factory returned the same server twice", name)
+		}
+	}
+}
@@ -135,6 +135,9 @@ func (config *S3MinioConfig) NewClient(ctx context.Context) (interface{}, error)
 		SkipBucketTagging:     config.SkipBucketTagging,
 		S3CompatMode:          config.S3CompatMode,
 		Edition:               detectEdition(ctx, minioAdmin, config.S3CompatMode, config.Edition),
+		DefaultTags:           config.DefaultTags,
+		HTTPTransport:         tr,
+		ValidateAgainstServer: config.ValidateAgainstServer,
 		RequestTimeoutSeconds: config.RequestTimeoutSeconds,
 		MaxRetries:            config.MaxRetries,
 		RetryDelayMs:          config.RetryDelayMs,
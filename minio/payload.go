@@ -1,6 +1,7 @@
 package minio
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/minio/madmin-go/v4"
@@ -27,6 +28,12 @@ type S3MinioConfig struct {
 	SkipBucketTagging bool
 	S3CompatMode      bool
 	Edition           string
+	DefaultTags       map[string]string
+	// HTTPTransport is the transport of the S3 and admin clients, with the
+	// provider's TLS settings, for other requests made by the provider.
+	HTTPTransport *http.Transport
+
+	ValidateAgainstServer bool
 
 	AssumeRoleARN         string
 	AssumeRoleSessionName string
@@ -55,7 +62,12 @@ type S3MinioClient struct {
 	SkipBucketTagging bool
 	S3CompatMode      bool
 	Edition           string
+	DefaultTags       map[string]string
+	// HTTPTransport is the transport of the S3 and admin clients, with the
+	// provider's TLS settings, for other requests made by the provider.
+	HTTPTransport *http.Transport
 
+	ValidateAgainstServer bool
 	RequestTimeoutSeconds int
 	MaxRetries            int
 	RetryDelayMs          int
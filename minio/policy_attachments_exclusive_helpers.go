@@ -0,0 +1,217 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
+)
+
+// ldapDNAttributeTypes are the attribute types of RFC 4514, plus uid, that
+// make up the distinguished names of LDAP users and groups.
+var ldapDNAttributeTypes = map[string]bool{
+	"c":      true,
+	"cn":     true,
+	"dc":     true,
+	"l":      true,
+	"o":      true,
+	"ou":     true,
+	"st":     true,
+	"street": true,
+	"uid":    true,
+}
+
+var ldapRDNPattern = regexp.MustCompile(`^\s*([A-Za-z][A-Za-z0-9-]*)\s*=`)
+
+// isLDAPDistinguishedName reports whether name is an LDAP distinguished name
+// rather than a built-in IAM user or group name: it has at least two
+// components, each an attribute=value pair with a standard DN attribute type
+// (e.g. "cn=admins,ou=groups,o=corp" or "uid=jdoe,dc=example,dc=org").
+func isLDAPDistinguishedName(name string) bool {
+	rdns := splitLDAPDistinguishedName(name)
+	if len(rdns) < 2 {
+		return false
+	}
+	for _, rdn := range rdns {
+		m := ldapRDNPattern.FindStringSubmatch(rdn)
+		if m == nil || !ldapDNAttributeTypes[strings.ToLower(m[1])] {
+			return false
+		}
+	}
+	return true
+}
+
+// splitLDAPDistinguishedName splits a DN on the commas that are not escaped
+// with a backslash.
+func splitLDAPDistinguishedName(dn string) []string {
+	var rdns []string
+	start, escaped := 0, false
+	for i, r := range dn {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			rdns = append(rdns, dn[start:i])
+			start = i + 1
+		}
+	}
+	return append(rdns, dn[start:])
+}
+
+// policyPrincipal identifies the user or group whose policies are managed by
+// an exclusive attachment resource. LDAP DNs are mapped through the LDAP
+// policy APIs, every other name through the built-in IAM APIs.
+type policyPrincipal struct {
+	group bool
+	name  string
+}
+
+func (p policyPrincipal) ldap() bool {
+	return isLDAPDistinguishedName(p.name)
+}
+
+func (p policyPrincipal) lock() func() {
+	var lock *MutexKV
+	switch {
+	case p.group && p.ldap():
+		lock = ldapGroupPolicyAttachmentLock
+	case p.group:
+		lock = groupPolicyAttachmentLock
+	case p.ldap():
+		lock = ldapUserPolicyAttachmentLock
+	default:
+		lock = userPolicyAttachmentLock
+	}
+	lock.Lock(p.name)
+	return func() { lock.Unlock(p.name) }
+}
+
+func (p policyPrincipal) policies(ctx context.Context, admin *madmin.AdminClient) ([]string, diag.Diagnostics) {
+	switch {
+	case p.group && p.ldap():
+		return minioReadLDAPGroupPolicies(ctx, admin, p.name)
+	case p.group:
+		return minioReadGroupPolicies(ctx, admin, p.name)
+	case p.ldap():
+		return minioReadLDAPUserPolicies(ctx, admin, p.name)
+	default:
+		return minioReadUserPolicies(ctx, admin, p.name)
+	}
+}
+
+func (p policyPrincipal) association(policies []string) madmin.PolicyAssociationReq {
+	req := madmin.PolicyAssociationReq{Policies: policies}
+	if p.group {
+		req.Group = p.name
+	} else {
+		req.User = p.name
+	}
+	return req
+}
+
+func (p policyPrincipal) attach(ctx context.Context, admin *madmin.AdminClient, policies []string) error {
+	if p.ldap() {
+		_, err := admin.AttachPolicyLDAP(ctx, p.association(policies))
+		return err
+	}
+	_, err := admin.AttachPolicy(ctx, p.association(policies))
+	return err
+}
+
+func (p policyPrincipal) detach(ctx context.Context, admin *madmin.AdminClient, policies []string) error {
+	if p.ldap() {
+		_, err := admin.DetachPolicyLDAP(ctx, p.association(policies))
+		return err
+	}
+	_, err := admin.DetachPolicy(ctx, p.association(policies))
+	return err
+}
+
+// diffPolicySets returns the policies in wanted but not in current, and the
+// policies in current but not in wanted, both sorted.
+func diffPolicySets(current, wanted []string) (toAttach, toDetach []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, p := range current {
+		currentSet[strings.TrimSpace(p)] = true
+	}
+	wantedSet := make(map[string]bool, len(wanted))
+	for _, p := range wanted {
+		wantedSet[p] = true
+		if !currentSet[p] {
+			toAttach = append(toAttach, p)
+		}
+	}
+	for p := range currentSet {
+		if p != "" && !wantedSet[p] {
+			toDetach = append(toDetach, p)
+		}
+	}
+	sort.Strings(toAttach)
+	sort.Strings(toDetach)
+	return toAttach, toDetach
+}
+
+// syncExclusivePolicies makes the policies attached to the principal exactly
+// match policy_names, detaching any policy attached outside Terraform.
+func syncExclusivePolicies(ctx context.Context, d *schema.ResourceData, meta interface{}, principal policyPrincipal) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	wanted := getStringList(d.Get("policy_names").(*schema.Set).List())
+
+	unlock := principal.lock()
+	defer unlock()
+
+	current, diags := principal.policies(ctx, admin)
+	if diags != nil {
+		return diags
+	}
+
+	toAttach, toDetach := diffPolicySets(current, wanted)
+
+	if len(toDetach) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Detaching policies %v from %s", toDetach, principal.name))
+		if err := principal.detach(ctx, admin, toDetach); err != nil {
+			return NewResourceError("unable to detach policies", principal.name, err)
+		}
+	}
+	if len(toAttach) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Attaching policies %v to %s", toAttach, principal.name))
+		if err := principal.attach(ctx, admin, toAttach); err != nil {
+			return NewResourceError("unable to attach policies", principal.name, err)
+		}
+	}
+
+	return nil
+}
+
+func readExclusivePolicies(ctx context.Context, d *schema.ResourceData, meta interface{}, principal policyPrincipal) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	unlock := principal.lock()
+	defer unlock()
+
+	current, diags := principal.policies(ctx, admin)
+	if diags != nil {
+		return diags
+	}
+
+	policies := make([]string, 0, len(current))
+	for _, p := range current {
+		if p = strings.TrimSpace(p); p != "" {
+			policies = append(policies, p)
+		}
+	}
+
+	if err := d.Set("policy_names", policies); err != nil {
+		return NewResourceError("setting policy_names", principal.name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	presignedURLDefaultExpiry = 3600
+	// presignedURLMaxExpiry is the longest validity SigV4 allows (7 days).
+	presignedURLMaxExpiry = 7 * 24 * 3600
+)
+
+var presignedURLMethods = []string{http.MethodGet, http.MethodPut, http.MethodHead, http.MethodPost}
+
+// presignedURLResponseHeaders are the response header overrides a GET or
+// HEAD URL may carry, as accepted in response_headers.
+var presignedURLResponseHeaders = []string{
+	"cache-control",
+	"content-disposition",
+	"content-encoding",
+	"content-language",
+	"content-type",
+	"expires",
+}
+
+// presignedURLRequest holds the arguments shared by the minio_s3_presigned_url
+// data source and ephemeral resource.
+type presignedURLRequest struct {
+	method           string
+	bucket           string
+	key              string
+	keyPrefix        string
+	versionID        string
+	contentType      string
+	expiresIn        int64
+	responseHeaders  map[string]string
+	contentLengthMin int64
+	contentLengthMax int64
+}
+
+type presignedURLResult struct {
+	url        string
+	expiration time.Time
+	// formFields are the fields to post along with the file (POST only).
+	formFields map[string]string
+	// headers are the request headers the URL was signed with and the
+	// client must send.
+	headers map[string]string
+}
+
+func (r presignedURLRequest) validate() error {
+	post := r.method == http.MethodPost
+	readOnly := r.method == http.MethodGet || r.method == http.MethodHead
+
+	switch {
+	case !post && r.keyPrefix != "":
+		return fmt.Errorf("key_prefix is only supported for POST policies")
+	case !post && r.key == "":
+		return fmt.Errorf("key is required for %s URLs", r.method)
+	case r.key != "" && r.keyPrefix != "":
+		return fmt.Errorf("only one of key and key_prefix can be set")
+	case post && r.key == "" && r.keyPrefix == "":
+		return fmt.Errorf("one of key or key_prefix is required for POST policies")
+	case !post && (r.contentLengthMin != 0 || r.contentLengthMax != 0):
+		return fmt.Errorf("content_length_min and content_length_max are only supported for POST policies")
+	case r.contentLengthMin < 0 || r.contentLengthMax < 0 || (r.contentLengthMax != 0 && r.contentLengthMin > r.contentLengthMax):
+		return fmt.Errorf("invalid content length range %d-%d", r.contentLengthMin, r.contentLengthMax)
+	case !readOnly && r.versionID != "":
+		return fmt.Errorf("version_id is only supported for GET and HEAD URLs")
+	case !readOnly && len(r.responseHeaders) > 0:
+		return fmt.Errorf("response_headers is only supported for GET and HEAD URLs")
+	case readOnly && r.contentType != "":
+		return fmt.Errorf("content_type is only supported for PUT URLs and POST policies")
+	case r.expiresIn < 1 || r.expiresIn > presignedURLMaxExpiry:
+		return fmt.Errorf("expires_in must be between 1 and %d seconds, got %d", presignedURLMaxExpiry, r.expiresIn)
+	}
+
+	for name := range r.responseHeaders {
+		if !slices.Contains(presignedURLResponseHeaders, strings.ToLower(name)) {
+			return fmt.Errorf("unsupported response header override %q, expected one of %s", name, strings.Join(presignedURLResponseHeaders, ", "))
+		}
+	}
+
+	return nil
+}
+
+// queryParams returns the signed query parameters: the version and the
+// response-* header overrides.
+func (r presignedURLRequest) queryParams() url.Values {
+	params := url.Values{}
+	if r.versionID != "" {
+		params.Set("versionId", r.versionID)
+	}
+	names := make([]string, 0, len(r.responseHeaders))
+	for name := range r.responseHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		params.Set("response-"+strings.ToLower(name), r.responseHeaders[name])
+	}
+	return params
+}
+
+func presignURL(ctx context.Context, client *minio.Client, r presignedURLRequest) (*presignedURLResult, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	expires := time.Duration(r.expiresIn) * time.Second
+	result := &presignedURLResult{
+		expiration: time.Now().Add(expires).UTC().Truncate(time.Second),
+		formFields: map[string]string{},
+		headers:    map[string]string{},
+	}
+
+	var (
+		u   *url.URL
+		err error
+	)
+	switch r.method {
+	case http.MethodGet:
+		u, err = client.PresignedGetObject(ctx, r.bucket, r.key, expires, r.queryParams())
+	case http.MethodHead:
+		u, err = client.PresignedHeadObject(ctx, r.bucket, r.key, expires, r.queryParams())
+	case http.MethodPut:
+		headers := http.Header{}
+		if r.contentType != "" {
+			headers.Set("Content-Type", r.contentType)
+			result.headers["Content-Type"] = r.contentType
+		}
+		u, err = client.PresignHeader(ctx, http.MethodPut, r.bucket, r.key, expires, nil, headers)
+	case http.MethodPost:
+		u, result.formFields, err = presignPostPolicy(ctx, client, r, result.expiration)
+	default:
+		return nil, fmt.Errorf("unsupported method %q", r.method)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result.url = u.String()
+	return result, nil
+}
+
+func presignPostPolicy(ctx context.Context, client *minio.Client, r presignedURLRequest, expiration time.Time) (*url.URL, map[string]string, error) {
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(r.bucket); err != nil {
+		return nil, nil, err
+	}
+	if r.key != "" {
+		if err := policy.SetKey(r.key); err != nil {
+			return nil, nil, err
+		}
+	} else if err := policy.SetKeyStartsWith(r.keyPrefix); err != nil {
+		return nil, nil, err
+	}
+	if err := policy.SetExpires(expiration); err != nil {
+		return nil, nil, err
+	}
+	if r.contentType != "" {
+		if err := policy.SetContentType(r.contentType); err != nil {
+			return nil, nil, err
+		}
+	}
+	if r.contentLengthMin != 0 || r.contentLengthMax != 0 {
+		// A minimum without a maximum leaves the upload size unbounded above.
+		maxLength := r.contentLengthMax
+		if maxLength == 0 {
+			maxLength = math.MaxInt64
+		}
+		if err := policy.SetContentLengthRange(r.contentLengthMin, maxLength); err != nil {
+			return nil, nil, err
+		}
+	}
+	return client.PresignedPostPolicy(ctx, policy)
+}
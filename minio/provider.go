@@ -148,6 +148,22 @@ func newProvider(envVarPrefix ...string) *schema.Provider {
 					prefix + "MINIO_SKIP_BUCKET_TAGGING",
 				}, false),
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags merged into the tags of every `minio_s3_bucket`, `minio_s3_bucket_tags` and `minio_s3_object_tags` resource. Tags set on a resource override default tags with the same key.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of tags applied to all resources that manage bucket or object tags.",
+						},
+					},
+				},
+			},
 			"s3_compat_mode": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -156,6 +172,14 @@ func newProvider(envVarPrefix ...string) *schema.Provider {
 					prefix + "MINIO_S3_COMPAT_MODE",
 				}, false),
 			},
+			"validate_against_server": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check planned changes against the server with read-only calls during plan, so that changes the server would reject fail at plan time: IAM policy documents, lifecycle transition tiers, server config keys, batch job definitions and the reachability of replication, tier and site endpoints. Defaults to `false`.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					prefix + "MINIO_VALIDATE_AGAINST_SERVER",
+				}, false),
+			},
 			"minio_edition": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -265,6 +289,10 @@ func newProvider(envVarPrefix ...string) *schema.Provider {
 			"minio_health_status":                       dataSourceMinioHealthStatus(),
 			"minio_kms_status":                          dataSourceMinioKMSStatus(),
 			"minio_kms_metrics":                         dataSourceMinioKMSMetrics(),
+			"minio_kms_keys":                            dataSourceMinioKMSKeys(),
+			"minio_kms_policies":                        dataSourceMinioKMSPolicies(),
+			"minio_kms_identities":                      dataSourceMinioKMSIdentities(),
+			"minio_iam_idp_openid_providers":            dataSourceMinioIAMIdpOpenIdProviders(),
 			"minio_prometheus_scrape_config":            dataSourceMinioPrometheusScrapeConfig(),
 			"minio_iam_group":                           dataSourceIAMGroup(),
 			"minio_iam_groups":                          dataSourceIAMGroups(),
@@ -272,6 +300,11 @@ func newProvider(envVarPrefix ...string) *schema.Provider {
 			"minio_s3_buckets":                          dataSourceMinioS3Buckets(),
 			"minio_ilm_tiers":                           dataSourceMinioILMTiers(),
 			"minio_iam_service_accounts":                dataSourceIAMServiceAccounts(),
+			"minio_iam_ldap_access_keys":                dataSourceMinioIAMLDAPAccessKeys(),
+			"minio_iam_ldap_policy_entities":            dataSourceMinioIAMLDAPPolicyEntities(),
+			"minio_iam_policy_simulation":               dataSourceMinioIAMPolicySimulation(),
+			"minio_iam_effective_policy":                dataSourceMinioIAMEffectivePolicy(),
+			"minio_s3_presigned_url":                    dataSourceMinioS3PresignedURL(),
 			"minio_license_info":                        dataSourceMinioLicenseInfo(),
 			"minio_s3_bucket_tags":                      dataSourceMinioS3BucketTags(),
 			"minio_s3_bucket":                           dataSourceMinioS3Bucket(),
@@ -324,6 +357,7 @@ func newProvider(envVarPrefix ...string) *schema.Provider {
 			"minio_s3_bucket_object_lock_configuration": resourceMinioS3BucketObjectLockConfiguration(),
 			"minio_s3_bucket_notification":              resourceMinioBucketNotification(),
 			"minio_s3_bucket_server_side_encryption":    resourceMinioBucketServerSideEncryption(),
+			"minio_s3_bucket_key_rotation":              resourceMinioS3BucketKeyRotation(),
 			"minio_s3_bucket_cors":                      resourceMinioS3BucketCors(),
 			"minio_s3_bucket_quota":                     resourceMinioBucketQuota(),
 			"minio_s3_bucket_lifecycle":                 resourceMinioS3BucketLifecycle(),
@@ -335,30 +369,37 @@ func newProvider(envVarPrefix ...string) *schema.Provider {
 			"minio_s3_incomplete_upload_cleanup":        resourceMinioS3IncompleteUploadCleanup(),
 
 			// IAM Operations
-			"minio_iam_group":                   resourceMinioIAMGroup(),
-			"minio_iam_group_membership":        resourceMinioIAMGroupMembership(),
-			"minio_iam_user":                    resourceMinioIAMUser(),
-			"minio_iam_service_account":         resourceMinioServiceAccount(),
-			"minio_iam_group_policy":            resourceMinioIAMGroupPolicy(),
-			"minio_iam_policy":                  resourceMinioIAMPolicy(),
-			"minio_iam_user_policy_attachment":  resourceMinioIAMUserPolicyAttachment(),
-			"minio_iam_group_policy_attachment": resourceMinioIAMGroupPolicyAttachment(),
-			"minio_iam_group_user_attachment":   resourceMinioIAMGroupUserAttachment(),
-			"minio_iam_user_group_membership":   resourceMinioIAMUserGroupMembership(),
-			"minio_iam_import":                  resourceMinioIAMImport(),
+			"minio_iam_group":                              resourceMinioIAMGroup(),
+			"minio_iam_group_membership":                   resourceMinioIAMGroupMembership(),
+			"minio_iam_user":                               resourceMinioIAMUser(),
+			"minio_iam_service_account":                    resourceMinioServiceAccount(),
+			"minio_iam_group_policy":                       resourceMinioIAMGroupPolicy(),
+			"minio_iam_policy":                             resourceMinioIAMPolicy(),
+			"minio_iam_user_policy_attachment":             resourceMinioIAMUserPolicyAttachment(),
+			"minio_iam_group_policy_attachment":            resourceMinioIAMGroupPolicyAttachment(),
+			"minio_iam_user_policy_attachments_exclusive":  resourceMinioIAMUserPolicyAttachmentsExclusive(),
+			"minio_iam_group_policy_attachments_exclusive": resourceMinioIAMGroupPolicyAttachmentsExclusive(),
+			"minio_iam_group_user_attachment":              resourceMinioIAMGroupUserAttachment(),
+			"minio_iam_user_group_membership":              resourceMinioIAMUserGroupMembership(),
+			"minio_iam_import":                             resourceMinioIAMImport(),
 
 			// LDAP Operations
 			"minio_iam_ldap_group_policy_attachment": resourceMinioIAMLDAPGroupPolicyAttachment(),
 			"minio_iam_ldap_user_policy_attachment":  resourceMinioIAMLDAPUserPolicyAttachment(),
+			"minio_iam_ldap_access_key":              resourceMinioIAMLDAPAccessKey(),
 
 			// Identity Provider Operations
-			"minio_iam_idp_openid": resourceMinioIAMIdpOpenId(),
-			"minio_iam_idp_ldap":   resourceMinioIAMIdpLdap(),
+			"minio_iam_idp_openid":   resourceMinioIAMIdpOpenId(),
+			"minio_iam_idp_ldap":     resourceMinioIAMIdpLdap(),
+			"minio_iam_idp_plugin":   resourceMinioIAMIdpPlugin(),
+			"minio_iam_authz_plugin": resourceMinioIAMAuthzPlugin(),
 
 			// ILM and KMS Operations
-			"minio_ilm_policy": resourceMinioILMPolicy(),
-			"minio_ilm_tier":   resourceMinioILMTier(),
-			"minio_kms_key":    resourceMinioKMSKey(),
+			"minio_ilm_policy":   resourceMinioILMPolicy(),
+			"minio_ilm_tier":     resourceMinioILMTier(),
+			"minio_kms_key":      resourceMinioKMSKey(),
+			"minio_kms_policy":   resourceMinioKMSPolicy(),
+			"minio_kms_identity": resourceMinioKMSIdentity(),
 
 			// AccessKey Operations
 			"minio_accesskey": resourceMinioAccessKey(),
@@ -373,6 +414,11 @@ func newProvider(envVarPrefix ...string) *schema.Provider {
 			"minio_server_config_heal":          resourceMinioServerConfigHeal(),
 			"minio_server_config_storage_class": resourceMinioServerConfigStorageClass(),
 			"minio_server_config_etcd":          resourceMinioServerConfigEtcd(),
+			"minio_server_config_compression":   resourceMinioServerConfigCompression(),
+			"minio_server_config_browser":       resourceMinioServerConfigBrowser(),
+			"minio_server_config_callhome":      resourceMinioServerConfigCallhome(),
+			"minio_server_config_drive":         resourceMinioServerConfigDrive(),
+			"minio_server_config_ilm":           resourceMinioServerConfigIlm(),
 			"minio_logger_webhook":              resourceMinioLoggerWebhook(),
 			"minio_audit_kafka":                 resourceMinioAuditKafka(),
 			"minio_site_replication":            resourceMinioSiteReplication(),
@@ -397,6 +443,9 @@ func newProvider(envVarPrefix ...string) *schema.Provider {
 			"minio_pool_rebalance":    resourceMinioPoolRebalance(),
 			"minio_pool_decommission": resourceMinioPoolDecommission(),
 
+			// Healing
+			"minio_heal": resourceMinioHeal(),
+
 			// Bucket Metadata
 			"minio_bucket_metadata_import": resourceMinioBucketMetadataImport(),
 
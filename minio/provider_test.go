@@ -9,6 +9,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -40,6 +41,25 @@ var testAccProviders = map[string]func() (*schema.Provider, error){
 	},
 }
 
+// testAccProtoV6ProviderFactories serve the muxed SDKv2 and framework
+// provider. Tests of framework-only features (ephemeral resources, functions,
+// list resources, actions) use these instead of testAccProviders.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"minio":       testAccProtoV6ProviderFactory(""),
+	"secondminio": testAccProtoV6ProviderFactory("SECOND_"),
+	"ldapminio":   testAccProtoV6ProviderFactory("LDAP_"),
+}
+
+func testAccProtoV6ProviderFactory(prefix string) func() (tfprotov6.ProviderServer, error) {
+	return func() (tfprotov6.ProviderServer, error) {
+		factory, err := newProtoV6ProviderServerFactory(context.Background(), prefix)
+		if err != nil {
+			return nil, err
+		}
+		return factory(), nil
+	}
+}
+
 type testAccClientResult struct {
 	client *S3MinioClient
 	err    error
@@ -24,8 +24,9 @@ func resourceMinioAccessKey() *schema.Resource {
 		UpdateContext: minioUpdateAccessKey,
 		DeleteContext: minioDeleteAccessKey,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: schema.ImportStatePassthroughWithIdentity("access_key"),
 		},
+		Identity: stringIdentity("access_key", "Access key ID."),
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			secretRaw := d.Get("secret_key")
 			secret := ""
@@ -339,6 +340,10 @@ func minioReadAccessKey(ctx context.Context, d *schema.ResourceData, meta interf
 	_ = d.Set("access_key", accessKeyID)
 	_ = d.Set("description", info.Description)
 
+	if err := setStringIdentity(d, "access_key"); err != nil {
+		return NewResourceError("reading access key", accessKeyID, err)
+	}
+
 	// Clear write-only secret fields from state.
 	_ = d.Set("secret_key", "")
 	_ = d.Set("secret_key_wo", "")
@@ -18,28 +18,41 @@ func resourceMinioBatchJob() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages a MinIO batch job (replicate, expire, or keyrotate). " +
 			"Batch jobs are asynchronous; this resource submits the job and tracks its status. " +
-			"Use `wait_for_status` to optionally block until the job reaches a desired state. " +
+			"Define the job either with one of the typed `replicate`, `expire` or `keyrotate` blocks, which the provider renders to YAML and checks at plan time against the keys of the server's job template, including nested ones, or with raw `job_yaml`. " +
+			"Use `wait_for_status` to optionally block until the job reaches a desired state, within the `create` timeout. " +
 			"Import is not supported because the job YAML definition cannot be retrieved from the MinIO API.",
 
 		CreateContext: minioCreateBatchJob,
 		ReadContext:   minioReadBatchJob,
 		UpdateContext: minioUpdateBatchJob,
 		DeleteContext: minioDeleteBatchJob,
+		CustomizeDiff: customizeDiffBatchJob,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"job_type": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Description: "Batch job type. The provider queries the server's supported types via `GetSupportedBatchJobTypes` at Create time and rejects values the server does not advertise. Typically one of `replicate`, `expire`, `keyrotate`.",
+				Description: "Batch job type. The provider queries the server's supported types via `GetSupportedBatchJobTypes` at Create time and rejects values the server does not advertise. Typically one of `replicate`, `expire`, `keyrotate`. Required with `job_yaml`; derived from the block when a typed block is used.",
 			},
 			"job_yaml": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Sensitive:   true,
-				Description: "YAML job definition for the batch operation.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: append([]string{"job_yaml"}, batchJobTypedBlocks...),
+				Description:  "YAML job definition for the batch operation. Conflicts with the typed `replicate`, `expire` and `keyrotate` blocks.",
 			},
+			"replicate": batchJobReplicateSchema(),
+			"expire":    batchJobExpireSchema(),
+			"keyrotate": batchJobKeyRotateSchema(),
 			"job_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -50,17 +63,47 @@ func resourceMinioBatchJob() *schema.Resource {
 				Computed:    true,
 				Description: "Current job status (`started`, `completed`, or `failed`).",
 			},
+			"cancel_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Cancel the job on the server via `CancelBatchJob` when the resource is destroyed or replaced and the job is still running. Set to `false` to let running jobs finish after they are removed from state. Defaults to `true`.",
+			},
+			"objects": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects processed so far.",
+			},
+			"objects_failed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects that failed processing.",
+			},
+			"bytes_transferred": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Bytes transferred so far. Only reported for `replicate` jobs.",
+			},
+			"last_update": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last progress update reported by the server, in RFC3339 format.",
+			},
+			"retry_attempts": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of retry attempts made by the job.",
+			},
 			"wait_for_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"completed"}, false),
-				Description:  "Block during Create until the job reaches this status. Only `completed` is supported; `started` is trivially true and `failed` is treated as an error during the wait.",
+				Description:  "Block during Create until the job reaches this status, at most for the `create` timeout. Only `completed` is supported; `started` is trivially true and `failed` is treated as an error during the wait.",
 			},
 			"wait_timeout_seconds": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Default:     300,
-				Description: "Maximum time in seconds to wait for `wait_for_status`. Defaults to 300.",
+				Description: "Maximum time in seconds to wait for `wait_for_status`. When not set, the wait lasts until the `create` timeout.",
+				Deprecated:  "Use the create timeout of the timeouts block instead. This attribute will be removed in a future major version.",
 			},
 		},
 	}
@@ -75,7 +118,16 @@ func minioCreateBatchJob(ctx context.Context, d *schema.ResourceData, meta inter
 		return diags
 	}
 
-	result, err := batchConfig.MinioAdmin.StartBatchJob(ctx, batchConfig.JobYAML)
+	jobYAML := batchConfig.JobYAML
+	if jobType := typedBatchJobType(d.Get); jobType != "" {
+		rendered, diags := renderTypedBatchJob(ctx, d, batchConfig.MinioAdmin, jobType)
+		if diags != nil {
+			return diags
+		}
+		jobYAML = rendered
+	}
+
+	result, err := batchConfig.MinioAdmin.StartBatchJob(ctx, jobYAML)
 	if err != nil {
 		return NewResourceError("starting batch job", batchConfig.JobType, err)
 	}
@@ -85,7 +137,11 @@ func minioCreateBatchJob(ctx context.Context, d *schema.ResourceData, meta inter
 	tflog.Debug(ctx, fmt.Sprintf("Created batch job: %s", result.ID))
 
 	if waitFor, ok := d.GetOk("wait_for_status"); ok {
-		timeout := time.Duration(d.Get("wait_timeout_seconds").(int)) * time.Second
+		// The deprecated wait_timeout_seconds overrides the create timeout when set.
+		timeout := remainingTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		if !d.GetRawConfig().GetAttr("wait_timeout_seconds").IsNull() {
+			timeout = time.Duration(d.Get("wait_timeout_seconds").(int)) * time.Second
+		}
 		if diags := waitForBatchJobStatus(ctx, batchConfig, result.ID, waitFor.(string), timeout); diags != nil {
 			return diags
 		}
@@ -124,6 +180,11 @@ func minioReadBatchJob(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("status", statusStr); err != nil {
 		return NewResourceError("setting status", jobID, err)
 	}
+	for k, v := range flattenBatchJobProgress(status) {
+		if err := d.Set(k, v); err != nil {
+			return NewResourceError("setting "+k, jobID, err)
+		}
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Read batch job: %s (status: %s)", jobID, statusStr))
 
@@ -138,6 +199,21 @@ func minioDeleteBatchJob(ctx context.Context, d *schema.ResourceData, meta inter
 	batchConfig := BatchJobConfig(d, meta)
 
 	jobID := d.Id()
+
+	if !batchJobCancelOnDestroy(d) {
+		tflog.Debug(ctx, fmt.Sprintf("cancel_on_destroy is false, leaving batch job %s running on the server", jobID))
+		d.SetId("")
+		return nil
+	}
+
+	if status, err := batchConfig.MinioAdmin.BatchJobStatus(ctx, jobID); err == nil {
+		if current := bareStatus(status); current != "started" {
+			tflog.Debug(ctx, fmt.Sprintf("Batch job %s already %s, skipping cancel", jobID, current))
+			d.SetId("")
+			return nil
+		}
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Deleting (cancelling) batch job: %s", jobID))
 
 	if err := batchConfig.MinioAdmin.CancelBatchJob(ctx, jobID); err != nil {
@@ -147,7 +223,7 @@ func minioDeleteBatchJob(ctx context.Context, d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return NewResourceError("cancelling batch job", jobID, err)
+		return NewResourceError("cancelling batch job", jobID, timeoutHint(ctx, err, schema.TimeoutDelete))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleted batch job: %s", jobID))
@@ -156,6 +232,90 @@ func minioDeleteBatchJob(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+// batchJobCancelOnDestroy reports whether destroy should cancel the job. The
+// attribute has no schema default so that state written before it existed does
+// not plan an update; a null value keeps the former always-cancel behavior.
+func batchJobCancelOnDestroy(d *schema.ResourceData) bool {
+	state := d.GetRawState()
+	if state.IsNull() || state.GetAttr("cancel_on_destroy").IsNull() {
+		return true
+	}
+	return d.Get("cancel_on_destroy").(bool)
+}
+
+func customizeDiffBatchJob(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	jobType := typedBatchJobType(d.Get)
+	if jobType == "" {
+		if d.Id() == "" && d.GetRawConfig().GetAttr("job_type").IsNull() {
+			return fmt.Errorf("job_type is required when the job is defined with job_yaml")
+		}
+		return nil
+	}
+
+	configured := d.GetRawConfig().GetAttr("job_type")
+	if configured.IsKnown() && !configured.IsNull() && configured.AsString() != jobType {
+		return fmt.Errorf("job_type %q does not match the %q block", configured.AsString(), jobType)
+	}
+
+	if d.Id() != "" {
+		for _, block := range batchJobTypedBlocks {
+			if d.HasChange(block) {
+				if err := d.ForceNew(block); err != nil {
+					return err
+				}
+			}
+		}
+		if !d.HasChange(jobType) {
+			return nil
+		}
+	}
+
+	if d.Get("job_type").(string) != jobType {
+		if err := d.SetNew("job_type", jobType); err != nil {
+			return err
+		}
+	}
+
+	block := d.Get(jobType).([]interface{})[0].(map[string]interface{})
+	rendered, err := renderBatchJobYAML(jobType, block, "", batchJobSecrets{})
+	if err != nil {
+		return err
+	}
+
+	tmpl, _, err := fetchBatchJobTemplate(ctx, meta.(*S3MinioClient).S3Admin, jobType)
+	if err != nil {
+		return serverValidationUnavailable(ctx, meta, fmt.Sprintf("the %s batch job", jobType), err)
+	}
+
+	return validateBatchJobAgainstTemplate(jobType, rendered, tmpl)
+}
+
+// renderTypedBatchJob renders the typed job block with its write-only credentials,
+// using the apiVersion advertised by the server's job template.
+func renderTypedBatchJob(ctx context.Context, d *schema.ResourceData, admin *madmin.AdminClient, jobType string) (string, diag.Diagnostics) {
+	tmpl, _, err := fetchBatchJobTemplate(ctx, admin, jobType)
+	if err != nil {
+		return "", NewResourceError("generating batch job template", jobType, err)
+	}
+
+	secrets, err := batchJobSecretsFromConfig(d)
+	if err != nil {
+		return "", NewResourceError("reading write-only credentials", jobType, err)
+	}
+
+	block := d.Get(jobType).([]interface{})[0].(map[string]interface{})
+	rendered, err := renderBatchJobYAML(jobType, block, batchJobTemplateAPIVersion(tmpl, jobType), secrets)
+	if err != nil {
+		return "", NewResourceError("rendering batch job", jobType, err)
+	}
+
+	if err := validateBatchJobAgainstTemplate(jobType, rendered, tmpl); err != nil {
+		return "", NewResourceError("validating batch job", jobType, err)
+	}
+
+	return rendered, nil
+}
+
 func validateBatchJobType(ctx context.Context, admin *madmin.AdminClient, jobType string) diag.Diagnostics {
 	if jobType == "" {
 		return NewResourceError("validating job_type", jobType, fmt.Errorf("job_type must not be empty"))
@@ -206,7 +366,7 @@ func waitForBatchJobStatus(ctx context.Context, config *S3MinioBatchJob, jobID s
 	})
 
 	if err != nil {
-		return NewResourceError("waiting for batch job status", jobID, err)
+		return NewResourceError("waiting for batch job status", jobID, timeoutHint(ctx, err, schema.TimeoutCreate))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Batch job %s reached status: %s", jobID, targetStatus))
@@ -1,9 +1,114 @@
 package minio
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/minio/madmin-go/v4"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
 func TestAccMinioBatchJob_basic(t *testing.T) {
 	t.Skip("Batch job tests require a pre-configured bucket and multi-cluster replication setup not available in the shared CI fixture. To run manually, create a bucket and set up the required replication configuration, then run with TF_ACC=1.")
 }
+
+func TestMinioDeleteBatchJobCancelOnDestroy(t *testing.T) {
+	cases := []struct {
+		name       string
+		attributes map[string]string
+		wantCancel bool
+	}{
+		{
+			name:       "state written before cancel_on_destroy existed",
+			attributes: map[string]string{},
+			wantCancel: true,
+		},
+		{
+			name:       "cancel_on_destroy true",
+			attributes: map[string]string{"cancel_on_destroy": "true"},
+			wantCancel: true,
+		},
+		{
+			name:       "cancel_on_destroy false",
+			attributes: map[string]string{"cancel_on_destroy": "false"},
+			wantCancel: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var cancelled bool
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/cancel-job") {
+					mu.Lock()
+					cancelled = true
+					mu.Unlock()
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				// Fail the status lookup so delete falls through to the cancel call.
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"Code":"XMinioInvalidArgument","Message":"unavailable"}`))
+			}))
+			defer srv.Close()
+
+			adminClient, err := madmin.NewWithOptions(strings.TrimPrefix(srv.URL, "http://"), &madmin.Options{
+				Creds:  credentials.NewStaticV4("accesskey", "secretkey", ""),
+				Secure: false,
+			})
+			if err != nil {
+				t.Fatalf("creating admin client: %v", err)
+			}
+
+			res := resourceMinioBatchJob()
+			attributes := map[string]string{"id": "job-1", "job_id": "job-1", "job_type": "replicate"}
+			for k, v := range tc.attributes {
+				attributes[k] = v
+			}
+			d := res.Data(&terraform.InstanceState{
+				ID:         "job-1",
+				Attributes: attributes,
+				RawState:   testBatchJobRawState(res.CoreConfigSchema().ImpliedType(), attributes),
+			})
+
+			diags := minioDeleteBatchJob(context.Background(), d, &S3MinioClient{S3Admin: adminClient})
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if cancelled != tc.wantCancel {
+				t.Errorf("expected cancel %v, got %v", tc.wantCancel, cancelled)
+			}
+			if d.Id() != "" {
+				t.Errorf("expected resource to be removed from state, got ID %q", d.Id())
+			}
+		})
+	}
+}
+
+// testBatchJobRawState builds the prior state Terraform sends on destroy, with
+// every attribute not in attributes left null.
+func testBatchJobRawState(ty cty.Type, attributes map[string]string) cty.Value {
+	vals := make(map[string]cty.Value, len(ty.AttributeTypes()))
+	for name, at := range ty.AttributeTypes() {
+		vals[name] = cty.NullVal(at)
+	}
+	for name, v := range attributes {
+		switch ty.AttributeType(name) {
+		case cty.Bool:
+			vals[name] = cty.BoolVal(v == "true")
+		default:
+			vals[name] = cty.StringVal(v)
+		}
+	}
+	return cty.ObjectVal(vals)
+}
@@ -18,11 +18,12 @@ import (
 
 func resourceMinioBucketMetadataImport() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Imports a base64-encoded zip stream of bucket metadata produced by `minio_bucket_metadata_export`. Destroying this resource only removes Terraform state; the imported metadata remains on the bucket. Use the `triggers` map to force a re-import.",
-		CreateContext: minioCreateBucketMetadataImport,
-		ReadContext:   minioReadBucketMetadataImport,
-		UpdateContext: minioReadBucketMetadataImport,
-		DeleteContext: minioDeleteBucketMetadataImport,
+		Description:        "Imports a base64-encoded zip stream of bucket metadata produced by `minio_bucket_metadata_export`. Destroying this resource only removes Terraform state; the imported metadata remains on the bucket. Use the `triggers` map to force a re-import.",
+		CreateContext:      minioCreateBucketMetadataImport,
+		DeprecationMessage: "Use the minio_bucket_metadata_import action instead (Terraform 1.14+). This resource will be removed in a future major version.",
+		ReadContext:        minioReadBucketMetadataImport,
+		UpdateContext:      minioReadBucketMetadataImport,
+		DeleteContext:      minioDeleteBucketMetadataImport,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
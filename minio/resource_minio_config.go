@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
 )
 
 func resourceMinioConfig() *schema.Resource {
@@ -18,6 +20,7 @@ func resourceMinioConfig() *schema.Resource {
 		ReadContext:   minioReadConfig,
 		UpdateContext: minioUpdateConfig,
 		DeleteContext: minioDeleteConfig,
+		CustomizeDiff: customizeDiffConfig,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -75,12 +78,54 @@ func resourceMinioConfig() *schema.Resource {
 			"restart_required": {
 				Type:        schema.TypeBool,
 				Computed:    true,
-				Description: "Indicates whether a server restart is required for the configuration to take effect",
+				Description: "Indicates whether a server restart is required for the configuration to take effect. Only known after apply, as the server's config help does not report it.",
 			},
 		},
 	}
 }
 
+// customizeDiffConfig validates the value against the server's help for the
+// subsystem. The help does not say whether a key needs a restart, so
+// restart_required is planned as unknown and set from the server's response
+// to SetConfigKV.
+func customizeDiffConfig(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("value") {
+		return nil
+	}
+
+	if err := d.SetNewComputed("restart_required"); err != nil {
+		return err
+	}
+
+	key := d.Get("key").(string)
+	rawValue := d.GetRawConfig().GetAttr("value")
+	if key == "" || !rawValue.IsKnown() || rawValue.IsNull() {
+		return nil
+	}
+
+	subsys := configSubsystem(key)
+	help, err := meta.(*S3MinioClient).S3Admin.HelpConfigKV(ctx, subsys, "", false)
+	if err != nil {
+		if isConfigHelpRejected(err) {
+			return fmt.Errorf("invalid config key %q: %w", key, err)
+		}
+		return serverValidationUnavailable(ctx, meta, fmt.Sprintf("the %s config", subsys), err)
+	}
+
+	keys := make(map[string]configHelpKey, len(help.KeysHelp))
+	for _, kh := range help.KeysHelp {
+		keys[kh.Key] = configHelpKey{Type: kh.Type, Description: kh.Description}
+	}
+
+	return validateConfigParams(subsys, keys, parseConfigParams(rawValue.AsString()))
+}
+
+// isConfigHelpRejected reports whether the server answered the help request
+// with a config error, as it does for a subsystem it does not know.
+func isConfigHelpRejected(err error) bool {
+	return madmin.ToErrorResponse(err).Code == "XMinioConfigError"
+}
+
 func minioCreateConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*S3MinioClient)
 	key := d.Get("key").(string)
@@ -276,22 +321,71 @@ func minioDeleteConfig(ctx context.Context, d *schema.ResourceData, meta interfa
 	return nil
 }
 
-// parseConfigParams parses a space-separated key=value string into a map
+// parseConfigParams parses a space-separated key=value string into a map.
+// Values wrapped in double quotes may contain spaces; the quotes are stripped.
 func parseConfigParams(configStr string) map[string]string {
 	params := make(map[string]string)
 	if configStr == "" {
 		return params
 	}
 
-	// Split by spaces to get individual key=value pairs
-	pairs := strings.Fields(configStr)
-	for _, pair := range pairs {
+	for _, pair := range splitConfigFields(configStr) {
 		// Split each pair by '=' to get key and value
 		parts := strings.SplitN(pair, "=", 2)
 		if len(parts) == 2 {
-			params[parts[0]] = parts[1]
+			params[parts[0]] = unquoteConfigValue(parts[1])
 		}
 	}
 
 	return params
 }
+
+// splitConfigFields splits a config string on whitespace, keeping double-quoted
+// sections (including escaped quotes) together.
+func splitConfigFields(configStr string) []string {
+	var fields []string
+	var current strings.Builder
+	inQuotes, escaped := false, false
+
+	for _, r := range configStr {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\' && inQuotes:
+			escaped = true
+		case r == '"':
+			inQuotes = !inQuotes
+		case !inQuotes && (r == ' ' || r == '\t' || r == '\n'):
+			if current.Len() > 0 {
+				fields = append(fields, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		fields = append(fields, current.String())
+	}
+
+	return fields
+}
+
+func unquoteConfigValue(v string) string {
+	if len(v) >= 2 && strings.HasPrefix(v, `"`) && strings.HasSuffix(v, `"`) {
+		if unquoted, err := strconv.Unquote(v); err == nil {
+			return unquoted
+		}
+		return v[1 : len(v)-1]
+	}
+	return v
+}
+
+// quoteConfigValue wraps a config value in double quotes when it contains
+// whitespace or quotes, so it survives SetConfigKV parsing.
+func quoteConfigValue(v string) string {
+	if strings.ContainsAny(v, " \t\"") {
+		return strconv.Quote(v)
+	}
+	return v
+}
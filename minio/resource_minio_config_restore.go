@@ -10,9 +10,10 @@ import (
 
 func resourceMinioConfigRestore() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: minioCreateConfigRestore,
-		ReadContext:   minioReadConfigRestore,
-		DeleteContext: minioDeleteConfigRestore,
+		CreateContext:      minioCreateConfigRestore,
+		DeprecationMessage: "Use the minio_config_restore action instead (Terraform 1.14+). This resource will be removed in a future major version.",
+		ReadContext:        minioReadConfigRestore,
+		DeleteContext:      minioDeleteConfigRestore,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -3,6 +3,7 @@ package minio
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -38,6 +39,56 @@ func TestAccMinioConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccMinioConfig_planTimeValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMinioConfigBasic("api", "requests_maximum=1000"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`unknown key "requests_maximum"`),
+			},
+			{
+				Config:      testAccMinioConfigBasic("api", "root_access=maybe"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`invalid value "maybe" for api.root_access`),
+			},
+			{
+				Config:      testAccMinioConfigBasic("no_such_subsys", "enable=on"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`invalid config key "no_such_subsys"`),
+			},
+		},
+	})
+}
+
+func TestAccMinioConfig_restartRequired(t *testing.T) {
+	resourceName := "minio_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckMinioConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioConfigBasic("logger_webhook:3", "enable=off batch_size=5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMinioConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "restart_required", "false"),
+				),
+			},
+			{
+				Config: testAccMinioConfigBasic("logger_webhook:3", "enable=off batch_size=10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMinioConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "restart_required", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMinioConfig_update(t *testing.T) {
 	resourceName := "minio_config.test"
 	configKey := "logger_webhook:2"
@@ -0,0 +1,291 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/minio/madmin-go/v4"
+)
+
+const (
+	healStatusFinished = "finished"
+	healStatusStopped  = "stopped"
+)
+
+func resourceMinioHeal() *schema.Resource {
+	return &schema.Resource{
+		Description: "Starts a server-side heal sequence via `Heal` for the whole cluster, a bucket or a prefix, and waits until it finishes. " +
+			"Exposes how many items were healed or failed and a summary of drive states before and after healing. " +
+			"Use `triggers` to run the heal again. Destroying this resource only removes it from Terraform state.",
+
+		CreateContext: minioCreateHeal,
+		ReadContext:   minioReadHeal,
+		DeleteContext: minioDeleteHeal,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Bucket to heal. Leave empty to heal all buckets.",
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"bucket"},
+				Description:  "Only heal objects under this prefix. Requires `bucket`.",
+			},
+			"recursive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Heal all objects under the bucket or prefix recursively. Defaults to `true`.",
+			},
+			"scan_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "normal",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"normal", "deep"}, false),
+				Description:  "Scan mode: `normal` checks metadata only, `deep` also verifies object data bitrot. Defaults to `normal`.",
+			},
+			"remove_dangling": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Remove dangling objects and parts that cannot be healed.",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Only report what would be healed without changing any data.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of strings to force a new heal sequence when changed.",
+			},
+			"client_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Token identifying the heal sequence on the server.",
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "RFC3339 timestamp of when the heal sequence was started.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Final status of the heal sequence as reported by the server (e.g. `finished`).",
+			},
+			"items_scanned": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of items (metadata, buckets and objects) scanned by the heal sequence.",
+			},
+			"items_healed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of items that had missing or corrupt drives before healing and none afterwards.",
+			},
+			"items_failed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of items that still have missing or corrupt drives after healing.",
+			},
+			"drives_before": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Count of drive states (`ok`, `missing`, `corrupt`, `offline`, ...) across all scanned items before healing.",
+			},
+			"drives_after": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Count of drive states (`ok`, `missing`, `corrupt`, `offline`, ...) across all scanned items after healing.",
+			},
+		},
+	}
+}
+
+// healSummary aggregates the result items of a heal sequence.
+type healSummary struct {
+	Scanned      int
+	Healed       int
+	Failed       int
+	DrivesBefore map[string]int
+	DrivesAfter  map[string]int
+}
+
+func newHealSummary() *healSummary {
+	return &healSummary{
+		DrivesBefore: map[string]int{},
+		DrivesAfter:  map[string]int{},
+	}
+}
+
+func (s *healSummary) add(items []madmin.HealResultItem) {
+	for _, item := range items {
+		s.Scanned++
+
+		unhealthyBefore := 0
+		for _, drive := range item.Before.Drives {
+			s.DrivesBefore[drive.State]++
+			if isUnhealthyHealDriveState(drive.State) {
+				unhealthyBefore++
+			}
+		}
+
+		unhealthyAfter := 0
+		for _, drive := range item.After.Drives {
+			s.DrivesAfter[drive.State]++
+			if isUnhealthyHealDriveState(drive.State) {
+				unhealthyAfter++
+			}
+		}
+
+		switch {
+		case unhealthyAfter > 0:
+			s.Failed++
+		case unhealthyBefore > 0:
+			s.Healed++
+		}
+	}
+}
+
+// isUnhealthyHealDriveState reports whether a drive state is one healing is
+// expected to fix. Offline drives are excluded because they cannot be healed
+// until they come back.
+func isUnhealthyHealDriveState(state string) bool {
+	return state == madmin.DriveStateMissing || state == madmin.DriveStateCorrupt
+}
+
+func healOptsFromResourceData(d *schema.ResourceData) madmin.HealOpts {
+	scanMode := madmin.HealNormalScan
+	if d.Get("scan_mode").(string) == "deep" {
+		scanMode = madmin.HealDeepScan
+	}
+
+	return madmin.HealOpts{
+		Recursive: d.Get("recursive").(bool),
+		DryRun:    d.Get("dry_run").(bool),
+		Remove:    d.Get("remove_dangling").(bool),
+		ScanMode:  scanMode,
+	}
+}
+
+func minioCreateHeal(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	opts := healOptsFromResourceData(d)
+	target := bucket + "/" + prefix
+
+	tflog.Debug(ctx, fmt.Sprintf("Starting heal sequence for %q", target))
+
+	start, status, err := admin.Heal(ctx, bucket, prefix, opts, "", false, false)
+	if err != nil {
+		return NewResourceError("starting heal sequence", target, err)
+	}
+
+	token := start.ClientToken
+	startedAt := start.StartTime
+	if startedAt.IsZero() {
+		startedAt = time.Now()
+	}
+
+	d.SetId(token)
+	if err := d.Set("client_token", token); err != nil {
+		return NewResourceError("setting client_token", token, err)
+	}
+	if err := d.Set("started_at", startedAt.UTC().Format(time.RFC3339)); err != nil {
+		return NewResourceError("setting started_at", token, err)
+	}
+
+	summary := newHealSummary()
+	summary.add(status.Items)
+	finalStatus := status.Summary
+
+	if finalStatus != healStatusFinished {
+		if diags := waitForHealSequence(ctx, admin, d.Timeout(schema.TimeoutCreate), bucket, prefix, opts, token, summary, &finalStatus); diags != nil {
+			return diags
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Heal sequence %s finished: %d scanned, %d healed, %d failed", token, summary.Scanned, summary.Healed, summary.Failed))
+
+	values := map[string]interface{}{
+		"status":        finalStatus,
+		"items_scanned": summary.Scanned,
+		"items_healed":  summary.Healed,
+		"items_failed":  summary.Failed,
+		"drives_before": summary.DrivesBefore,
+		"drives_after":  summary.DrivesAfter,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return NewResourceError("setting "+k, token, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForHealSequence polls the heal sequence identified by token until it
+// finishes, accumulating the result items reported on each poll into summary.
+func waitForHealSequence(ctx context.Context, admin *madmin.AdminClient, timeout time.Duration, bucket, prefix string, opts madmin.HealOpts, token string, summary *healSummary, finalStatus *string) diag.Diagnostics {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		_, current, err := admin.Heal(ctx, bucket, prefix, opts, token, false, false)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		summary.add(current.Items)
+		*finalStatus = current.Summary
+
+		switch current.Summary {
+		case healStatusFinished:
+			return nil
+		case healStatusStopped:
+			return retry.NonRetryableError(fmt.Errorf("heal sequence stopped: %s", current.FailureDetail))
+		default:
+			return retry.RetryableError(fmt.Errorf("heal sequence %s is %s", token, current.Summary))
+		}
+	})
+	if err != nil {
+		return NewResourceError("waiting for heal sequence", token, err)
+	}
+
+	return nil
+}
+
+func minioReadHeal(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading heal sequence (no-op): %s", d.Id()))
+	return nil
+}
+
+func minioDeleteHeal(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting heal sequence (state-only, no API call): %s", d.Id()))
+	d.SetId("")
+	return nil
+}
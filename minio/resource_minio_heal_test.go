@@ -0,0 +1,88 @@
+package minio
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/minio/madmin-go/v4"
+)
+
+func TestAccMinioHeal_dryRun(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "minio_heal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioHealConfig(bucketName, "run1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "client_token"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_at"),
+					resource.TestCheckResourceAttr(resourceName, "status", "finished"),
+					resource.TestCheckResourceAttr(resourceName, "items_failed", "0"),
+				),
+			},
+			{
+				Config: testAccMinioHealConfig(bucketName, "run2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "run2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "finished"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMinioHealConfig(bucketName, run string) string {
+	return fmt.Sprintf(`
+resource "minio_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "minio_heal" "test" {
+  bucket    = minio_s3_bucket.test.bucket
+  scan_mode = "normal"
+  dry_run   = true
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, bucketName, run)
+}
+
+func TestHealSummaryAdd(t *testing.T) {
+	drives := func(states ...string) []madmin.HealDriveInfo {
+		out := make([]madmin.HealDriveInfo, len(states))
+		for i, s := range states {
+			out[i] = madmin.HealDriveInfo{State: s}
+		}
+		return out
+	}
+
+	var healed, failed, healthy madmin.HealResultItem
+	healed.Before.Drives = drives(madmin.DriveStateOk, madmin.DriveStateMissing)
+	healed.After.Drives = drives(madmin.DriveStateOk, madmin.DriveStateOk)
+	failed.Before.Drives = drives(madmin.DriveStateCorrupt, madmin.DriveStateOk)
+	failed.After.Drives = drives(madmin.DriveStateCorrupt, madmin.DriveStateOk)
+	healthy.Before.Drives = drives(madmin.DriveStateOk, madmin.DriveStateOffline)
+	healthy.After.Drives = drives(madmin.DriveStateOk, madmin.DriveStateOffline)
+
+	summary := newHealSummary()
+	summary.add([]madmin.HealResultItem{healed, failed})
+	summary.add([]madmin.HealResultItem{healthy})
+
+	if summary.Scanned != 3 || summary.Healed != 1 || summary.Failed != 1 {
+		t.Errorf("unexpected counts: scanned=%d healed=%d failed=%d", summary.Scanned, summary.Healed, summary.Failed)
+	}
+	if summary.DrivesBefore[madmin.DriveStateOk] != 3 || summary.DrivesBefore[madmin.DriveStateMissing] != 1 {
+		t.Errorf("unexpected drives_before: %v", summary.DrivesBefore)
+	}
+	if summary.DrivesAfter[madmin.DriveStateOk] != 4 || summary.DrivesAfter[madmin.DriveStateCorrupt] != 1 {
+		t.Errorf("unexpected drives_after: %v", summary.DrivesAfter)
+	}
+}
@@ -0,0 +1,148 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const authzPluginSubsys = "policy_plugin"
+
+var authzPluginFields = serverConfigFields{
+	values: []string{"url", "comment"},
+	bools:  []string{"enable_http2"},
+}
+
+func resourceMinioIAMAuthzPlugin() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the MinIO access management plugin (`policy_plugin`, formerly `policy_opa`), which delegates authorization " +
+			"decisions to an external service such as Open Policy Agent.",
+		CreateContext: minioCreateAuthzPlugin,
+		ReadContext:   minioReadAuthzPlugin,
+		UpdateContext: minioUpdateAuthzPlugin,
+		DeleteContext: minioDeleteAuthzPlugin,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL of the authorization plugin endpoint (e.g. `http://opa:8181/v1/data/httpapi/authz/allow`).",
+			},
+			"auth_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Authorization header value sent to the plugin (e.g. `Bearer <token>`). MinIO does not return this value on read; Terraform keeps the value from your configuration.",
+			},
+			"enable_http2": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Use HTTP/2 when connecting to the plugin.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comment for this configuration.",
+			},
+			"restart_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether a MinIO server restart is required for the configuration to take effect.",
+			},
+		},
+	}
+}
+
+func minioCreateAuthzPlugin(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Creating authorization plugin configuration")
+
+	if diags := setAuthzPluginConfig(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	d.SetId(authzPluginSubsys)
+
+	return minioReadAuthzPlugin(ctx, d, meta)
+}
+
+func minioUpdateAuthzPlugin(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Updating authorization plugin configuration")
+
+	if diags := setAuthzPluginConfig(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	return minioReadAuthzPlugin(ctx, d, meta)
+}
+
+func setAuthzPluginConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	parts := serverConfigParams(d, authzPluginFields)
+	if token := d.Get("auth_token").(string); token != "" {
+		parts = append(parts, "auth_token="+quoteConfigValue(token))
+	}
+
+	restart, err := admin.SetConfigKV(ctx, authzPluginSubsys+" "+strings.Join(parts, " "))
+	if err != nil {
+		return NewResourceError("setting authorization plugin configuration", authzPluginSubsys, err)
+	}
+
+	if setErr := d.Set("restart_required", restart); setErr != nil {
+		return NewResourceError("setting restart_required", authzPluginSubsys, setErr)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Set authorization plugin configuration (restart_required=%v)", restart))
+
+	return nil
+}
+
+func minioReadAuthzPlugin(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	tflog.Debug(ctx, "Reading authorization plugin configuration")
+
+	cfgMap, err := getServerConfigSubsys(ctx, admin, authzPluginSubsys)
+	if err != nil {
+		return NewResourceError("reading authorization plugin configuration", authzPluginSubsys, err)
+	}
+
+	if cfgMap["url"] == "" {
+		if d.Get("restart_required").(bool) {
+			tflog.Warn(ctx, "Authorization plugin configuration is not yet visible; a MinIO server restart is required for it to take effect. Keeping configured values in state")
+			return nil
+		}
+		tflog.Warn(ctx, "Authorization plugin configuration no longer exists, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	if err := setServerConfigFields(d, cfgMap, authzPluginFields); err != nil {
+		return NewResourceError("setting authorization plugin configuration", authzPluginSubsys, err)
+	}
+
+	return nil
+}
+
+func minioDeleteAuthzPlugin(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	tflog.Debug(ctx, "Deleting authorization plugin configuration")
+
+	if _, err := admin.DelConfigKV(ctx, authzPluginSubsys); err != nil && !isIDPConfigNotFound(err) {
+		return NewResourceError("deleting authorization plugin configuration", authzPluginSubsys, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,57 @@
+package minio
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccMinioIAMAuthzPlugin_basic requires MINIO_AUTHZ_PLUGIN_URL to point at
+// a running authorization plugin (e.g. OPA). Enabling the plugin affects every
+// request to the server, so this is skipped by default.
+func TestAccMinioIAMAuthzPlugin_basic(t *testing.T) {
+	pluginURL := os.Getenv("MINIO_AUTHZ_PLUGIN_URL")
+	if pluginURL == "" {
+		t.Skip("MINIO_AUTHZ_PLUGIN_URL not set — skipping authorization plugin acceptance test")
+	}
+
+	resourceName := "minio_iam_authz_plugin.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioIAMAuthzPluginConfig(pluginURL, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "url", pluginURL),
+					resource.TestCheckResourceAttr(resourceName, "enable_http2", "false"),
+				),
+			},
+			{
+				Config: testAccMinioIAMAuthzPluginConfig(pluginURL, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable_http2", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_token", "restart_required"},
+			},
+		},
+	})
+}
+
+func testAccMinioIAMAuthzPluginConfig(url string, http2 bool) string {
+	return fmt.Sprintf(`
+resource "minio_iam_authz_plugin" "test" {
+  url          = %q
+  auth_token   = "Bearer tfacc-token"
+  enable_http2 = %t
+}
+`, url, http2)
+}
@@ -24,8 +24,9 @@ func resourceMinioIAMGroup() *schema.Resource {
 		UpdateContext: minioUpdateGroup,
 		DeleteContext: minioDeleteGroup,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: schema.ImportStatePassthroughWithIdentity("name"),
 		},
+		Identity: stringIdentity("name", "Name of the group."),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -134,6 +135,14 @@ func minioReadGroup(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	tflog.Warn(ctx, fmt.Sprintf("(%v)", output))
 
+	if _, ok := d.GetOk("name"); !ok {
+		_ = d.Set("name", d.Id())
+	}
+
+	if err := setStringIdentity(d, "name"); err != nil {
+		return NewResourceError("error reading IAM Group %s: %s", d.Id(), err)
+	}
+
 	if err := d.Set("group_name", output.Name); err != nil {
 		return NewResourceError("error reading IAM Group %s: %s", d.Id(), err)
 	}
@@ -17,6 +17,7 @@ func resourceMinioIAMGroupPolicy() *schema.Resource {
 		ReadContext:   minioReadGroupPolicy,
 		UpdateContext: minioUpdateGroupPolicy,
 		DeleteContext: minioDeleteGroupPolicy,
+		CustomizeDiff: customizeDiffIAMPolicyDocument,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -0,0 +1,91 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceMinioIAMGroupPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the complete set of policies attached to a group. Policies attached outside Terraform are detached on apply " +
+			"and shown as drift on plan. Works for built-in groups and LDAP group DNs. " +
+			"Do not combine with `minio_iam_group_policy_attachment` or `minio_iam_ldap_group_policy_attachment` for the same group. " +
+			"Destroying this resource only removes it from Terraform state; attached policies are left in place.",
+		CreateContext: minioCreateGroupPolicyAttachmentsExclusive,
+		ReadContext:   minioReadGroupPolicyAttachmentsExclusive,
+		UpdateContext: minioUpdateGroupPolicyAttachmentsExclusive,
+		DeleteContext: minioDeleteGroupPolicyAttachmentsExclusive,
+		Importer: &schema.ResourceImporter{
+			StateContext: minioImportGroupPolicyAttachmentsExclusive,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressLDAPDNDiffs,
+				Description:      "Name of the group, or DN of the LDAP group.",
+			},
+			"policy_names": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateIAMNamePolicy},
+				Description: "Names of all policies attached to the group. An empty set detaches every policy.",
+			},
+		},
+	}
+}
+
+func minioCreateGroupPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("group_name").(string)
+
+	if diags := syncExclusivePolicies(ctx, d, meta, policyPrincipal{group: true, name: name}); diags != nil {
+		return diags
+	}
+
+	d.SetId(name)
+
+	return minioReadGroupPolicyAttachmentsExclusive(ctx, d, meta)
+}
+
+func minioUpdateGroupPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := syncExclusivePolicies(ctx, d, meta, policyPrincipal{group: true, name: d.Id()}); diags != nil {
+		return diags
+	}
+
+	return minioReadGroupPolicyAttachmentsExclusive(ctx, d, meta)
+}
+
+func minioReadGroupPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading exclusive policy attachments of group %s", d.Id()))
+
+	if diags := readExclusivePolicies(ctx, d, meta, policyPrincipal{group: true, name: d.Id()}); diags != nil {
+		return diags
+	}
+
+	if !suppressLDAPDNDiffs("group_name", d.Get("group_name").(string), d.Id(), d) {
+		_ = d.Set("group_name", d.Id())
+	}
+
+	return nil
+}
+
+func minioDeleteGroupPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Removing exclusive policy attachments of group %s from state, policies are left attached", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+func minioImportGroupPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <group-name>", d.Id())
+	}
+	_ = d.Set("group_name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
@@ -142,6 +142,11 @@ func resourceMinioIAMIdpOpenId() *schema.Resource {
 				Default:     true,
 				Description: "Whether this OIDC configuration is enabled.",
 			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Role ARN generated by MinIO when role_policy is set. Use it as role_arn in AssumeRoleWithWebIdentity requests and in the provider's assume_role_with_web_identity block. Empty for claim-based configurations or until a required restart has happened.",
+			},
 			"restart_required": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -282,9 +287,34 @@ func minioReadIdpOpenIdConfig(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	roleARN, err := idpOpenIdRoleARN(ctx, minioAdmin, cfgName)
+	if err != nil {
+		return NewResourceError("listing OIDC IDP configurations", cfgName, err)
+	}
+	if setErr := d.Set("role_arn", roleARN); setErr != nil {
+		return NewResourceError("setting role_arn", cfgName, setErr)
+	}
+
 	return nil
 }
 
+// idpOpenIdRoleARN returns the role ARN MinIO generated for the named OIDC
+// configuration. Only configurations using role_policy have one.
+func idpOpenIdRoleARN(ctx context.Context, minioAdmin *madmin.AdminClient, cfgName string) (string, error) {
+	items, err := minioAdmin.ListIDPConfig(ctx, madmin.OpenidIDPCfg)
+	if err != nil {
+		return "", err
+	}
+
+	for _, item := range items {
+		if item.Name == cfgName {
+			return item.RoleARN, nil
+		}
+	}
+
+	return "", nil
+}
+
 func minioUpdateIdpOpenId(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := IdpOpenIdConfig(d, meta)
 	clientSecretWO, hasClientSecretWO, err := getWriteOnlyStringAt(d, cty.GetAttrPath("client_secret_wo"), "client_secret_wo")
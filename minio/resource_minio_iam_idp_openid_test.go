@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -237,6 +238,66 @@ func TestAccMinioIAMIdpOpenId_writeOnlyClientSecret_transition(t *testing.T) {
 	})
 }
 
+func TestAccMinioIAMIdpOpenId_roleARN(t *testing.T) {
+	resourceName := "minio_iam_idp_openid.test"
+	dataSourceName := "data.minio_iam_idp_openid_providers.test"
+	cfgName := "tfacc-oidc-role-" + acctest.RandString(6)
+	configURL := os.Getenv("MINIO_OIDC_CONFIG_URL")
+	clientID := os.Getenv("MINIO_OIDC_CLIENT_ID")
+	clientSecret := os.Getenv("MINIO_OIDC_CLIENT_SECRET")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccOIDCPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckMinioIAMIdpOpenIdDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioIAMIdpOpenIdRolePolicy(cfgName, configURL, clientID, clientSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role_policy", "readonly"),
+					testAccOIDCRestartAndExists(resourceName),
+				),
+			},
+			{
+				// After the restart the configuration is visible, so the
+				// refresh picks up the generated role ARN.
+				Config: testAccMinioIAMIdpOpenIdRolePolicyWithProviders(cfgName, configURL, clientID, clientSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "role_arn", regexp.MustCompile(`^arn:minio:iam:`)),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "providers.*", map[string]string{
+						"name":        cfgName,
+						"role_policy": "readonly",
+						"client_id":   clientID,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "providers.*.role_arn", resourceName, "role_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenIdpOpenIdProvider_redactsSecrets(t *testing.T) {
+	item := madmin.IDPListItem{Name: "corp", Enabled: true, RoleARN: "arn:minio:iam:::role/abc"}
+	provider := flattenIdpOpenIdProvider(item, map[string]string{
+		"config_url":    "https://idp.example.com/.well-known/openid-configuration",
+		"client_id":     "minio",
+		"client_secret": "hunter2",
+		"role_policy":   "readonly",
+		"vendor":        "keycloak",
+	})
+
+	settings := provider["settings"].(map[string]interface{})
+	if _, ok := settings["client_secret"]; ok {
+		t.Errorf("settings must not contain client_secret")
+	}
+	if settings["vendor"] != "keycloak" {
+		t.Errorf("settings[vendor] = %v, want keycloak", settings["vendor"])
+	}
+	if provider["role_arn"] != item.RoleARN || provider["client_id"] != "minio" || provider["claim_name"] != "" {
+		t.Errorf("unexpected provider attributes: %v", provider)
+	}
+}
+
 func testAccMinioIAMIdpOpenIdBasic(name, configURL, clientID, clientSecret string) string {
 	return fmt.Sprintf(`
 resource "minio_iam_idp_openid" "test" {
@@ -271,3 +332,23 @@ resource "minio_iam_idp_openid" "test" {
 }
 `, name, configURL, clientID, clientSecret, version)
 }
+
+func testAccMinioIAMIdpOpenIdRolePolicy(name, configURL, clientID, clientSecret string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_idp_openid" "test" {
+  name          = %[1]q
+  config_url    = %[2]q
+  client_id     = %[3]q
+  client_secret = %[4]q
+  role_policy   = "readonly"
+}
+`, name, configURL, clientID, clientSecret)
+}
+
+func testAccMinioIAMIdpOpenIdRolePolicyWithProviders(name, configURL, clientID, clientSecret string) string {
+	return testAccMinioIAMIdpOpenIdRolePolicy(name, configURL, clientID, clientSecret) + `
+data "minio_iam_idp_openid_providers" "test" {
+  depends_on = [minio_iam_idp_openid.test]
+}
+`
+}
@@ -0,0 +1,174 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const idpPluginSubsys = "identity_plugin"
+
+var idpPluginFields = serverConfigFields{
+	values: []string{"url", "role_policy", "role_id", "comment"},
+}
+
+func resourceMinioIAMIdpPlugin() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the MinIO identity plugin (`identity_plugin`), which delegates authentication to an external web service " +
+			"through `AssumeRoleWithCustomToken`.",
+		CreateContext: minioCreateIdpPlugin,
+		ReadContext:   minioReadIdpPlugin,
+		UpdateContext: minioUpdateIdpPlugin,
+		DeleteContext: minioDeleteIdpPlugin,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL of the identity plugin web service.",
+			},
+			"auth_token_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				RequiredWith: []string{"auth_token_wo_version"},
+				Description:  "Write-only authorization header value sent to the plugin (e.g. `Bearer <token>`).",
+			},
+			"auth_token_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"auth_token_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Version identifier for auth_token_wo. Change this value to trigger updates when using auth_token_wo.",
+			},
+			"role_policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Comma-separated policies applied to users authenticated by the plugin.",
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Unique ID used to generate the role ARN. Generated by the server when not set.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comment for this configuration.",
+			},
+			"restart_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether a MinIO server restart is required for the configuration to take effect.",
+			},
+		},
+	}
+}
+
+func minioCreateIdpPlugin(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Creating identity plugin configuration")
+
+	if diags := setIdpPluginConfig(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	d.SetId(idpPluginSubsys)
+
+	return minioReadIdpPlugin(ctx, d, meta)
+}
+
+func minioUpdateIdpPlugin(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, hasVersion := d.GetOk("auth_token_wo_version")
+	if d.HasChange("auth_token_wo_version") && hasVersion {
+		if _, hasToken, _ := getWriteOnlyStringAt(d, cty.GetAttrPath("auth_token_wo"), "auth_token_wo"); !hasToken {
+			return NewResourceError("updating identity plugin configuration", idpPluginSubsys, fmt.Errorf("auth_token_wo must be provided when auth_token_wo_version changes"))
+		}
+	}
+
+	tflog.Debug(ctx, "Updating identity plugin configuration")
+
+	if diags := setIdpPluginConfig(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	return minioReadIdpPlugin(ctx, d, meta)
+}
+
+func setIdpPluginConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	parts := serverConfigParams(d, idpPluginFields)
+	token, hasToken, err := getWriteOnlyStringAt(d, cty.GetAttrPath("auth_token_wo"), "auth_token_wo")
+	if err != nil {
+		return NewResourceError("retrieving auth_token_wo", idpPluginSubsys, err)
+	}
+	if hasToken {
+		parts = append(parts, "auth_token="+quoteConfigValue(token))
+	}
+
+	restart, err := admin.SetConfigKV(ctx, idpPluginSubsys+" "+strings.Join(parts, " "))
+	if err != nil {
+		return NewResourceError("setting identity plugin configuration", idpPluginSubsys, err)
+	}
+
+	if setErr := d.Set("restart_required", restart); setErr != nil {
+		return NewResourceError("setting restart_required", idpPluginSubsys, setErr)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Set identity plugin configuration (restart_required=%v)", restart))
+
+	return nil
+}
+
+func minioReadIdpPlugin(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	tflog.Debug(ctx, "Reading identity plugin configuration")
+
+	cfgMap, err := getServerConfigSubsys(ctx, admin, idpPluginSubsys)
+	if err != nil {
+		return NewResourceError("reading identity plugin configuration", idpPluginSubsys, err)
+	}
+
+	if cfgMap["url"] == "" {
+		if d.Get("restart_required").(bool) {
+			tflog.Warn(ctx, "Identity plugin configuration is not yet visible; a MinIO server restart is required for it to take effect. Keeping configured values in state")
+			return nil
+		}
+		tflog.Warn(ctx, "Identity plugin configuration no longer exists, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	if err := setServerConfigFields(d, cfgMap, idpPluginFields); err != nil {
+		return NewResourceError("setting identity plugin configuration", idpPluginSubsys, err)
+	}
+
+	return nil
+}
+
+func minioDeleteIdpPlugin(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	tflog.Debug(ctx, "Deleting identity plugin configuration")
+
+	if _, err := admin.DelConfigKV(ctx, idpPluginSubsys); err != nil && !isIDPConfigNotFound(err) {
+		return NewResourceError("deleting identity plugin configuration", idpPluginSubsys, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,61 @@
+package minio
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccMinioIAMIdpPlugin_basic requires MINIO_IDENTITY_PLUGIN_URL to point at
+// a running identity plugin service.
+func TestAccMinioIAMIdpPlugin_basic(t *testing.T) {
+	pluginURL := os.Getenv("MINIO_IDENTITY_PLUGIN_URL")
+	if pluginURL == "" {
+		t.Skip("MINIO_IDENTITY_PLUGIN_URL not set — skipping identity plugin acceptance test")
+	}
+
+	resourceName := "minio_iam_idp_plugin.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioIAMIdpPluginConfig(pluginURL, "readonly", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "url", pluginURL),
+					resource.TestCheckResourceAttr(resourceName, "role_policy", "readonly"),
+					resource.TestCheckResourceAttr(resourceName, "role_id", "tfacc"),
+					resource.TestCheckNoResourceAttr(resourceName, "auth_token_wo"),
+				),
+			},
+			{
+				Config: testAccMinioIAMIdpPluginConfig(pluginURL, "readwrite", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role_policy", "readwrite"),
+					resource.TestCheckResourceAttr(resourceName, "auth_token_wo_version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_token_wo_version", "restart_required"},
+			},
+		},
+	})
+}
+
+func testAccMinioIAMIdpPluginConfig(url, rolePolicy string, tokenVersion int) string {
+	return fmt.Sprintf(`
+resource "minio_iam_idp_plugin" "test" {
+  url                   = %[1]q
+  role_policy           = %[2]q
+  role_id               = "tfacc"
+  auth_token_wo         = "Bearer tfacc-token-%[3]d"
+  auth_token_wo_version = %[3]d
+}
+`, url, rolePolicy, tokenVersion)
+}
@@ -0,0 +1,312 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/minio/madmin-go/v4"
+)
+
+func resourceMinioIAMLDAPAccessKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages an access key for an LDAP user. Without `policy`, the access key inherits the policies mapped to the user's DN " +
+			"and to the LDAP groups the user belongs to; with `policy`, access is further restricted to that inline policy.",
+		CreateContext: minioCreateLDAPAccessKey,
+		ReadContext:   minioReadLDAPAccessKey,
+		UpdateContext: minioUpdateLDAPAccessKey,
+		DeleteContext: minioDeleteLDAPAccessKey,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"user_dn": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressLDAPDNDiffs,
+				Description:      "DN of the LDAP user the access key is created for.",
+			},
+			"access_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 20),
+				Description:  "Access key ID. Generated by the server when not set.",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Secret key generated by the server. Empty when secret_key_wo is used.",
+			},
+			"secret_key_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				RequiredWith: []string{"secret_key_wo_version"},
+				ValidateFunc: validation.StringLenBetween(8, 40),
+				Description:  "Write-only secret key for the access key. When set, the secret is never stored in state.",
+			},
+			"secret_key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"secret_key_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Version identifier for secret_key_wo. Increment this integer to rotate the secret key.",
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateIAMPolicyJSON,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				Description:      "Inline policy JSON restricting the access key. When not set, the access key inherits the policies of the LDAP user.",
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: stringChangedToEmpty,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 32)),
+				Description:      "Name of the access key (32 bytes max), can't be cleared once set.",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: stringChangedToEmpty,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+				Description:      "Description of the access key (256 bytes max), can't be cleared once set.",
+			},
+			"expiration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateExpiration,
+				DiffSuppressFunc: suppressTimeDiffs,
+				Description:      "Expiration of the access key in RFC3339 format. Must be between NOW+15min & NOW+365d. If not set, the access key does not expire.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the access key (`on` or `off`).",
+			},
+		},
+	}
+}
+
+func minioCreateLDAPAccessKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	userDN := d.Get("user_dn").(string)
+
+	secretWO, hasSecretWO, err := getWriteOnlyStringAt(d, cty.GetAttrPath("secret_key_wo"), "secret_key_wo")
+	if err != nil {
+		return NewResourceError("retrieving secret_key_wo", userDN, err)
+	}
+
+	expiration, err := parseLDAPAccessKeyExpiration(d.Get("expiration").(string))
+	if err != nil {
+		return NewResourceError("parsing expiration", userDN, err)
+	}
+
+	req := madmin.AddServiceAccountReq{
+		TargetUser:  userDN,
+		AccessKey:   d.Get("access_key").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Expiration:  expiration,
+	}
+	if policy := d.Get("policy").(string); policy != "" {
+		req.Policy = []byte(policy)
+	}
+	if hasSecretWO {
+		req.SecretKey = secretWO
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating LDAP access key for %s", userDN))
+
+	creds, err := admin.AddServiceAccountLDAP(ctx, req)
+	if err != nil {
+		return NewResourceError("creating LDAP access key", userDN, err)
+	}
+
+	d.SetId(creds.AccessKey)
+	_ = d.Set("access_key", creds.AccessKey)
+	if hasSecretWO {
+		_ = d.Set("secret_key", "")
+	} else {
+		_ = d.Set("secret_key", creds.SecretKey)
+	}
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		if _, err := admin.InfoServiceAccount(ctx, creds.AccessKey); err != nil {
+			return retry.RetryableError(fmt.Errorf("waiting for LDAP access key %s to become available: %w", creds.AccessKey, err))
+		}
+		return nil
+	})
+	if err != nil {
+		return NewResourceError("waiting for LDAP access key readiness", creds.AccessKey, err)
+	}
+
+	return minioReadLDAPAccessKey(ctx, d, meta)
+}
+
+func minioUpdateLDAPAccessKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	accessKey := d.Id()
+
+	req := madmin.UpdateServiceAccountReq{}
+
+	if d.HasChange("policy") {
+		// An empty policy document reverts the access key to the policies
+		// inherited from the LDAP user.
+		req.NewPolicy = processServiceAccountPolicy(d.Get("policy").(string))
+	}
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		if name == "" {
+			return NewResourceError("MinIO does not support removing access key names", accessKey, name)
+		}
+		req.NewName = name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		if description == "" {
+			return NewResourceError("MinIO does not support removing access key descriptions", accessKey, description)
+		}
+		req.NewDescription = description
+	}
+
+	if d.HasChange("expiration") {
+		expiration, err := parseLDAPAccessKeyExpiration(d.Get("expiration").(string))
+		if err != nil {
+			return NewResourceError("parsing expiration", accessKey, err)
+		}
+		req.NewExpiration = expiration
+	}
+
+	_, hasSecretWOVersion := d.GetOk("secret_key_wo_version")
+	if d.HasChange("secret_key_wo_version") && hasSecretWOVersion {
+		secretWO, hasSecretWO, err := getWriteOnlyStringAt(d, cty.GetAttrPath("secret_key_wo"), "secret_key_wo")
+		if err != nil {
+			return NewResourceError("retrieving secret_key_wo", accessKey, err)
+		}
+		if !hasSecretWO {
+			return NewResourceError("rotating LDAP access key secret", accessKey, fmt.Errorf("secret_key_wo must be provided when secret_key_wo_version changes"))
+		}
+		req.NewSecretKey = secretWO
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating LDAP access key %s", accessKey))
+
+	if err := admin.UpdateServiceAccount(ctx, accessKey, req); err != nil {
+		return NewResourceError("updating LDAP access key", accessKey, err)
+	}
+	if req.NewSecretKey != "" {
+		_ = d.Set("secret_key", "")
+	}
+
+	return minioReadLDAPAccessKey(ctx, d, meta)
+}
+
+func minioReadLDAPAccessKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	accessKey := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading LDAP access key %s", accessKey))
+
+	info, err := admin.InfoServiceAccount(ctx, accessKey)
+	if err != nil {
+		if isServiceAccountNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("LDAP access key %s no longer exists, removing from state", accessKey))
+			d.SetId("")
+			return nil
+		}
+		return NewResourceError("reading LDAP access key", accessKey, err)
+	}
+
+	_ = d.Set("access_key", accessKey)
+	_ = d.Set("status", info.AccountStatus)
+	if !suppressLDAPDNDiffs("user_dn", d.Get("user_dn").(string), info.ParentUser, d) {
+		_ = d.Set("user_dn", info.ParentUser)
+	}
+
+	if info.ImpliedPolicy {
+		_ = d.Set("policy", "")
+	} else {
+		normalized, err := NormalizeAndCompareJSONPolicies(d.Get("policy").(string), info.Policy)
+		if err != nil {
+			normalized = info.Policy
+		}
+		_ = d.Set("policy", normalized)
+	}
+
+	_ = d.Set("name", info.Name)
+	_ = d.Set("description", info.Description)
+
+	var expiration string
+	if info.Expiration != nil && !info.Expiration.IsZero() && info.Expiration.Unix() != 0 {
+		expiration = info.Expiration.Format(time.RFC3339)
+	}
+	_ = d.Set("expiration", expiration)
+
+	return nil
+}
+
+func minioDeleteLDAPAccessKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	accessKey := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting LDAP access key %s", accessKey))
+
+	if err := admin.DeleteServiceAccount(ctx, accessKey); err != nil && !isServiceAccountNotFound(err) {
+		return NewResourceError("deleting LDAP access key", accessKey, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseLDAPAccessKeyExpiration(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	expiration, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &expiration, nil
+}
+
+func isServiceAccountNotFound(err error) bool {
+	return strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "service account does not exist")
+}
+
+// suppressLDAPDNDiffs treats DNs as equal when they only differ in case or in
+// whitespace around RDN separators, since MinIO normalizes DNs it stores.
+func suppressLDAPDNDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeLDAPDN(old) == normalizeLDAPDN(new)
+}
+
+func normalizeLDAPDN(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, part := range parts {
+		if attr, value, found := strings.Cut(part, "="); found {
+			part = strings.TrimSpace(attr) + "=" + strings.TrimSpace(value)
+		}
+		parts[i] = strings.TrimSpace(part)
+	}
+	return strings.ToLower(strings.Join(parts, ","))
+}
@@ -0,0 +1,154 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMinioIAMLDAPAccessKey_basic(t *testing.T) {
+	userDN := os.Getenv("MINIO_LDAP_TEST_USER_DN")
+	resourceName := "minio_iam_ldap_access_key.test"
+	dataSourceName := "data.minio_iam_ldap_access_keys.test"
+	name := "tfacc-" + acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccLDAPAttachmentPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLDAPAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPAccessKeyConfig(userDN, name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_key"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "status", "on"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "access_keys.*.access_key", resourceName, "access_key"),
+				),
+			},
+			{
+				Config: testAccLDAPAccessKeyConfig(userDN, name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func TestAccMinioIAMLDAPAccessKey_inlinePolicyWriteOnlySecret(t *testing.T) {
+	userDN := os.Getenv("MINIO_LDAP_TEST_USER_DN")
+	resourceName := "minio_iam_ldap_access_key.test"
+	accessKey := "tfacc" + acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccLDAPAttachmentPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckLDAPAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPAccessKeyWriteOnlyConfig(userDN, accessKey, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access_key", accessKey),
+					resource.TestCheckResourceAttr(resourceName, "secret_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "secret_key_wo_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				Config: testAccLDAPAccessKeyWriteOnlyConfig(userDN, accessKey, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestNormalizeLDAPDN(t *testing.T) {
+	cases := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"uid=dillon,ou=people,dc=example,dc=org", "uid=dillon,ou=people,dc=example,dc=org", true},
+		{"UID=Dillon, OU=People, DC=example, DC=org", "uid=dillon,ou=people,dc=example,dc=org", true},
+		{"uid = dillon,ou=people,dc=example,dc=org", "uid=dillon,ou=people,dc=example,dc=org", true},
+		{"uid=dillon,ou=people,dc=example,dc=org", "uid=liza,ou=people,dc=example,dc=org", false},
+	}
+
+	for _, c := range cases {
+		if got := normalizeLDAPDN(c.a) == normalizeLDAPDN(c.b); got != c.equal {
+			t.Errorf("normalizeLDAPDN(%q) == normalizeLDAPDN(%q) = %v, want %v", c.a, c.b, got, c.equal)
+		}
+	}
+}
+
+func testAccCheckLDAPAccessKeyDestroy(s *terraform.State) error {
+	ldap, err := testAccLdapClient()
+	if err != nil {
+		return err
+	}
+	client := ldap.S3Admin
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "minio_iam_ldap_access_key" {
+			continue
+		}
+
+		if _, err := client.InfoServiceAccount(context.Background(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("LDAP access key %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccLDAPAccessKeyConfig(userDN, name, description string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_ldap_access_key" "test" {
+  provider    = ldapminio
+  user_dn     = %[1]q
+  name        = %[2]q
+  description = %[3]q
+}
+
+data "minio_iam_ldap_access_keys" "test" {
+  provider = ldapminio
+  user_dn  = minio_iam_ldap_access_key.test.user_dn
+}
+`, userDN, name, description)
+}
+
+func testAccLDAPAccessKeyWriteOnlyConfig(userDN, accessKey string, version int) string {
+	return fmt.Sprintf(`
+resource "minio_iam_ldap_access_key" "test" {
+  provider              = ldapminio
+  user_dn               = %[1]q
+  access_key            = %[2]q
+  secret_key_wo         = "tfacc-secret-v%[3]d"
+  secret_key_wo_version = %[3]d
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject"]
+      Resource = ["arn:aws:s3:::tfacc-ldap/*"]
+    }]
+  })
+}
+`, userDN, accessKey, version)
+}
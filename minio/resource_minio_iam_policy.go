@@ -23,8 +23,10 @@ func resourceMinioIAMPolicy() *schema.Resource {
 		UpdateContext: minioUpdatePolicy,
 		DeleteContext: minioDeletePolicy,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: schema.ImportStatePassthroughWithIdentity("name"),
 		},
+		Identity:      stringIdentity("name", "Name of the policy."),
+		CustomizeDiff: customizeDiffIAMPolicyDocument,
 
 		Schema: map[string]*schema.Schema{
 			"policy": {
@@ -105,6 +107,10 @@ func minioReadPolicy(ctx context.Context, d *schema.ResourceData, meta interface
 		return NewResourceError("setting policy name", d.Id(), err)
 	}
 
+	if err := setStringIdentity(d, "name"); err != nil {
+		return NewResourceError("setting policy identity", d.Id(), err)
+	}
+
 	actualPolicyText := strings.TrimSpace(string(info.Policy))
 	existingPolicy := ""
 	if v, ok := d.GetOk("policy"); ok {
@@ -0,0 +1,282 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccMinioIAMUserPolicyAttachmentsExclusive_basic(t *testing.T) {
+	userName := "tfacc-usr-excl-" + acctest.RandString(6)
+	policy1 := "tfacc-pol1-" + acctest.RandString(6)
+	policy2 := "tfacc-pol2-" + acctest.RandString(6)
+	resourceName := "minio_iam_user_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMUserPolicyAttachmentsExclusiveConfig(userName, policy1, policy2, "pol1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy_names.*", policy1),
+					// Attach a policy outside Terraform; the refresh must report it as drift.
+					testAccAttachPolicyOutOfBand(policyPrincipal{name: userName}, policy2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Re-applying the same configuration detaches the out-of-band policy.
+				Config: testAccIAMUserPolicyAttachmentsExclusiveConfig(userName, policy1, policy2, "pol1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					testAccCheckExclusivePolicies(policyPrincipal{name: userName}, policy1),
+				),
+			},
+			{
+				Config: testAccIAMUserPolicyAttachmentsExclusiveConfig(userName, policy1, policy2, "pol2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(resourceName, "policy_names.*", policy2),
+					testAccCheckExclusivePolicies(policyPrincipal{name: userName}, policy2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     userName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMinioIAMGroupPolicyAttachmentsExclusive_basic(t *testing.T) {
+	groupName := "tfacc-grp-excl-" + acctest.RandString(6)
+	policy1 := "tfacc-pol1-" + acctest.RandString(6)
+	policy2 := "tfacc-pol2-" + acctest.RandString(6)
+	resourceName := "minio_iam_group_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIAMGroupPolicyAttachmentsExclusiveConfig(groupName, policy1, policy2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "group_name", groupName),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+					testAccAttachPolicyOutOfBand(policyPrincipal{group: true, name: groupName}, policy2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccIAMGroupPolicyAttachmentsExclusiveConfig(groupName, policy1, policy2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExclusivePolicies(policyPrincipal{group: true, name: groupName}, policy1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMinioIAMPolicyAttachmentsExclusive_ldap(t *testing.T) {
+	userDN := os.Getenv("MINIO_LDAP_TEST_USER_DN")
+	groupDN := os.Getenv("MINIO_LDAP_TEST_GROUP_DN")
+	user := policyPrincipal{name: userDN}
+	group := policyPrincipal{group: true, name: groupDN}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccLDAPAttachmentPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPPolicyAttachmentsExclusiveConfig(userDN, groupDN, `"readonly"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("minio_iam_user_policy_attachments_exclusive.test", "user_name", userDN),
+					resource.TestCheckResourceAttr("minio_iam_group_policy_attachments_exclusive.test", "group_name", groupDN),
+					testAccCheckExclusivePolicies(user, "readonly"),
+					testAccCheckExclusivePolicies(group, "readonly"),
+					testAccAttachPolicyOutOfBand(user, "diagnostics"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccLDAPPolicyAttachmentsExclusiveConfig(userDN, groupDN, `"readonly"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExclusivePolicies(user, "readonly"),
+				),
+			},
+			{
+				Config: testAccLDAPPolicyAttachmentsExclusiveConfig(userDN, groupDN, `"diagnostics"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExclusivePolicies(user, "diagnostics"),
+					testAccCheckExclusivePolicies(group, "diagnostics"),
+				),
+			},
+			{
+				// Detach everything, since destroying the resources leaves the policies in place.
+				Config: testAccLDAPPolicyAttachmentsExclusiveConfig(userDN, groupDN, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExclusivePolicies(user),
+					testAccCheckExclusivePolicies(group),
+				),
+			},
+		},
+	})
+}
+
+func TestDiffPolicySets(t *testing.T) {
+	toAttach, toDetach := diffPolicySets([]string{"readonly", " diagnostics", "app"}, []string{"app", "writeonly"})
+	if !reflect.DeepEqual(toAttach, []string{"writeonly"}) {
+		t.Errorf("toAttach = %v, want [writeonly]", toAttach)
+	}
+	if !reflect.DeepEqual(toDetach, []string{"diagnostics", "readonly"}) {
+		t.Errorf("toDetach = %v, want [diagnostics readonly]", toDetach)
+	}
+
+	toAttach, toDetach = diffPolicySets(nil, nil)
+	if len(toAttach) != 0 || len(toDetach) != 0 {
+		t.Errorf("diffPolicySets(nil, nil) = %v, %v, want empty", toAttach, toDetach)
+	}
+}
+
+func TestIsLDAPDistinguishedName(t *testing.T) {
+	cases := map[string]bool{
+		"uid=dillon,ou=people,dc=example,dc=org":        true,
+		"cn=Project Team, ou=groups, dc=example,dc=org": true,
+		"cn=admins,ou=groups,o=corp":                    true,
+		`cn=Smith\, John,ou=people,o=corp`:              true,
+		"uid=dillon":                                    false,
+		"alice":                                         false,
+		"alice=admin,team=storage":                      false,
+		"user@example.com":                              false,
+	}
+	for name, want := range cases {
+		if got := isLDAPDistinguishedName(name); got != want {
+			t.Errorf("isLDAPDistinguishedName(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+// testAccExclusivePrincipalClient returns the client of the server holding
+// the principal: the LDAP-enabled server for DNs, the default one otherwise.
+func testAccExclusivePrincipalClient(principal policyPrincipal) (*S3MinioClient, error) {
+	if principal.ldap() {
+		return testAccLdapClient()
+	}
+	return testAccClient(), nil
+}
+
+func testAccAttachPolicyOutOfBand(principal policyPrincipal, policy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := testAccExclusivePrincipalClient(principal)
+		if err != nil {
+			return err
+		}
+		return principal.attach(context.Background(), client.S3Admin, []string{policy})
+	}
+}
+
+func testAccCheckExclusivePolicies(principal policyPrincipal, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := testAccExclusivePrincipalClient(principal)
+		if err != nil {
+			return err
+		}
+		current, diags := principal.policies(context.Background(), client.S3Admin)
+		if diags != nil {
+			return fmt.Errorf("reading policies of %s: %v", principal.name, diags)
+		}
+		toAttach, toDetach := diffPolicySets(current, want)
+		if len(toAttach) > 0 || len(toDetach) > 0 {
+			return fmt.Errorf("policies of %s are %v, want %v", principal.name, current, want)
+		}
+		return nil
+	}
+}
+
+func testAccIAMUserPolicyAttachmentsExclusiveConfig(userName, policy1, policy2, attached string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "minio_iam_policy" "pol1" {
+  name   = %[2]q
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = ["s3:GetObject"], Resource = ["arn:aws:s3:::*"] }]
+  })
+}
+
+resource "minio_iam_policy" "pol2" {
+  name   = %[3]q
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = ["s3:PutObject"], Resource = ["arn:aws:s3:::*"] }]
+  })
+}
+
+resource "minio_iam_user_policy_attachments_exclusive" "test" {
+  user_name    = minio_iam_user.test.name
+  policy_names = [minio_iam_policy.%[4]s.name]
+
+  depends_on = [minio_iam_policy.pol1, minio_iam_policy.pol2]
+}
+`, userName, policy1, policy2, attached)
+}
+
+func testAccIAMGroupPolicyAttachmentsExclusiveConfig(groupName, policy1, policy2 string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_group" "test" {
+  name = %[1]q
+}
+
+resource "minio_iam_policy" "pol1" {
+  name   = %[2]q
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = ["s3:GetObject"], Resource = ["arn:aws:s3:::*"] }]
+  })
+}
+
+resource "minio_iam_policy" "pol2" {
+  name   = %[3]q
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = ["s3:PutObject"], Resource = ["arn:aws:s3:::*"] }]
+  })
+}
+
+resource "minio_iam_group_policy_attachments_exclusive" "test" {
+  group_name   = minio_iam_group.test.name
+  policy_names = [minio_iam_policy.pol1.name]
+
+  depends_on = [minio_iam_policy.pol2]
+}
+`, groupName, policy1, policy2)
+}
+
+func testAccLDAPPolicyAttachmentsExclusiveConfig(userDN, groupDN, policies string) string {
+	return fmt.Sprintf(`
+resource "minio_iam_user_policy_attachments_exclusive" "test" {
+  provider     = ldapminio
+  user_name    = %[1]q
+  policy_names = [%[3]s]
+}
+
+resource "minio_iam_group_policy_attachments_exclusive" "test" {
+  provider     = ldapminio
+  group_name   = %[2]q
+  policy_names = [%[3]s]
+}
+`, userDN, groupDN, policies)
+}
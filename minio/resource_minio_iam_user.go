@@ -27,8 +27,9 @@ func resourceMinioIAMUser() *schema.Resource {
 		UpdateContext: minioUpdateUser,
 		DeleteContext: minioDeleteUser,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: schema.ImportStatePassthroughWithIdentity("name"),
 		},
+		Identity: stringIdentity("name", "Name of the user."),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -225,6 +226,10 @@ func minioReadUser(ctx context.Context, d *schema.ResourceData, meta interface{}
 		_ = d.Set("name", d.Id())
 	}
 
+	if err := setStringIdentity(d, "name"); err != nil {
+		return NewResourceError("reading IAM user failed", d.Id(), err)
+	}
+
 	if err := d.Set("status", string(output.Status)); err != nil {
 		return NewResourceError("reading IAM user failed", d.Id(), err)
 	}
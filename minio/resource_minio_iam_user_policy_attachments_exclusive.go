@@ -0,0 +1,91 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceMinioIAMUserPolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the complete set of policies attached to a user. Policies attached outside Terraform are detached on apply " +
+			"and shown as drift on plan. Works for built-in users and LDAP user DNs. " +
+			"Do not combine with `minio_iam_user_policy_attachment` or `minio_iam_ldap_user_policy_attachment` for the same user. " +
+			"Destroying this resource only removes it from Terraform state; attached policies are left in place.",
+		CreateContext: minioCreateUserPolicyAttachmentsExclusive,
+		ReadContext:   minioReadUserPolicyAttachmentsExclusive,
+		UpdateContext: minioUpdateUserPolicyAttachmentsExclusive,
+		DeleteContext: minioDeleteUserPolicyAttachmentsExclusive,
+		Importer: &schema.ResourceImporter{
+			StateContext: minioImportUserPolicyAttachmentsExclusive,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressLDAPDNDiffs,
+				Description:      "Name of the user, or DN of the LDAP user.",
+			},
+			"policy_names": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateIAMNamePolicy},
+				Description: "Names of all policies attached to the user. An empty set detaches every policy.",
+			},
+		},
+	}
+}
+
+func minioCreateUserPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("user_name").(string)
+
+	if diags := syncExclusivePolicies(ctx, d, meta, policyPrincipal{group: false, name: name}); diags != nil {
+		return diags
+	}
+
+	d.SetId(name)
+
+	return minioReadUserPolicyAttachmentsExclusive(ctx, d, meta)
+}
+
+func minioUpdateUserPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := syncExclusivePolicies(ctx, d, meta, policyPrincipal{group: false, name: d.Id()}); diags != nil {
+		return diags
+	}
+
+	return minioReadUserPolicyAttachmentsExclusive(ctx, d, meta)
+}
+
+func minioReadUserPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading exclusive policy attachments of user %s", d.Id()))
+
+	if diags := readExclusivePolicies(ctx, d, meta, policyPrincipal{group: false, name: d.Id()}); diags != nil {
+		return diags
+	}
+
+	if !suppressLDAPDNDiffs("user_name", d.Get("user_name").(string), d.Id(), d) {
+		_ = d.Set("user_name", d.Id())
+	}
+
+	return nil
+}
+
+func minioDeleteUserPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Removing exclusive policy attachments of user %s from state, policies are left attached", d.Id()))
+	d.SetId("")
+	return nil
+}
+
+func minioImportUserPolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <user-name>", d.Id())
+	}
+	_ = d.Set("user_name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,9 +20,16 @@ func resourceMinioILMTier() *schema.Resource {
 		ReadContext:   minioReadILMTier,
 		DeleteContext: minioDeleteILMTier,
 		UpdateContext: minioUpdateILMTier,
+		CustomizeDiff: customizeDiffILMTier,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Description: "Manages remote storage tiers for MinIO ILM (Information Lifecycle Management). Tiers allow transitioning objects to cheaper remote storage (S3, GCS, Azure, or another MinIO deployment) based on lifecycle rules.",
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -195,6 +203,20 @@ func resourceMinioILMTier() *schema.Resource {
 	}
 }
 
+// customizeDiffILMTier probes the remote storage endpoint when
+// validate_against_server is set.
+func customizeDiffILMTier(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !validateAgainstServer(meta) || !d.HasChange("endpoint") {
+		return nil
+	}
+
+	endpoint := d.Get("endpoint").(string)
+	if endpoint == "" {
+		return nil
+	}
+	return checkEndpointReachable(ctx, meta, endpointBaseURL(endpoint, true))
+}
+
 func minioCreateILMTier(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var err error
 	var tierConf *madmin.TierConfig
@@ -295,7 +317,7 @@ func minioCreateILMTier(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 	err = c.AddTier(ctx, tierConf)
 	if err != nil {
-		return NewResourceError("adding remote tier failed", name, err)
+		return NewResourceError("adding remote tier failed", name, timeoutHint(ctx, err, schema.TimeoutCreate))
 	}
 	d.SetId(name)
 	tflog.Debug(ctx, fmt.Sprintf("Created Tier %s", name))
@@ -383,7 +405,7 @@ func minioDeleteILMTier(ctx context.Context, d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return NewResourceError("deleting remote tier failed", d.Id(), err)
+		return NewResourceError("deleting remote tier failed", d.Id(), timeoutHint(ctx, err, schema.TimeoutDelete))
 	}
 	d.SetId("")
 	return nil
@@ -420,7 +442,7 @@ func minioUpdateILMTier(ctx context.Context, d *schema.ResourceData, meta interf
 	if d.HasChanges("minio_config", "gcs_config", "azure_config", "s3_config") {
 		err := c.EditTier(ctx, name, credentials)
 		if err != nil {
-			return NewResourceError("updating ILM tier", d.Id(), err)
+			return NewResourceError("updating ILM tier", d.Id(), timeoutHint(ctx, err, schema.TimeoutUpdate))
 		}
 	}
 	return minioReadILMTier(ctx, d, meta)
@@ -0,0 +1,136 @@
+package minio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var kmsIdentityRegexp = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+func resourceMinioKMSIdentity() *schema.Resource {
+	return &schema.Resource{
+		Description: "Assigns a KMS (KES) policy to a client identity. The identity is the hex-encoded SHA-256 fingerprint of the client's TLS certificate public key, " +
+			"as printed by `kes identity of <cert>`. Destroying this resource deletes the identity from the KMS.",
+		CreateContext: minioCreateKMSIdentity,
+		ReadContext:   minioReadKMSIdentity,
+		UpdateContext: minioUpdateKMSIdentity,
+		DeleteContext: minioDeleteKMSIdentity,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(kmsIdentityRegexp, "must be a hex-encoded SHA-256 fingerprint"),
+				Description:  "Identity of the KMS client (hex-encoded SHA-256 fingerprint of its certificate public key).",
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the KMS policy to assign to the identity.",
+			},
+			"is_admin": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the identity is the KMS admin identity.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the identity was assigned.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity that assigned the policy.",
+			},
+		},
+	}
+}
+
+func minioCreateKMSIdentity(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	identity := d.Get("identity").(string)
+
+	if diags := assignKMSPolicy(ctx, d, meta, identity); diags != nil {
+		return diags
+	}
+
+	d.SetId(identity)
+
+	return minioReadKMSIdentity(ctx, d, meta)
+}
+
+func minioUpdateKMSIdentity(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := assignKMSPolicy(ctx, d, meta, d.Id()); diags != nil {
+		return diags
+	}
+
+	return minioReadKMSIdentity(ctx, d, meta)
+}
+
+func assignKMSPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}, identity string) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	policy := d.Get("policy").(string)
+
+	content, err := json.Marshal(map[string]string{"identity": identity})
+	if err != nil {
+		return NewResourceError("encoding KMS identity", identity, err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Assigning KMS policy [%s] to identity [%s]", policy, identity))
+
+	if err := admin.AssignPolicy(ctx, policy, content); err != nil {
+		return NewResourceError("error assigning KMS policy", identity, err)
+	}
+
+	return nil
+}
+
+func minioReadKMSIdentity(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	identity := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading KMS identity [%s]", identity))
+
+	info, err := admin.DescribeIdentity(ctx, identity)
+	if err != nil {
+		if isKMSNotFoundError(err) {
+			tflog.Warn(ctx, fmt.Sprintf("KMS identity [%s] not found, removing from state", identity))
+			d.SetId("")
+			return nil
+		}
+		return NewResourceError("error reading KMS identity", identity, err)
+	}
+
+	_ = d.Set("identity", identity)
+	_ = d.Set("policy", info.Policy)
+	_ = d.Set("is_admin", info.IsAdmin)
+	_ = d.Set("created_at", info.CreatedAt)
+	_ = d.Set("created_by", info.CreatedBy)
+
+	return nil
+}
+
+func minioDeleteKMSIdentity(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting KMS identity [%s]", d.Id()))
+
+	if err := admin.DeleteIdentity(ctx, d.Id()); err != nil && !isKMSNotFoundError(err) {
+		return NewResourceError("unable to remove KMS identity", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,80 @@
+package minio
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMinioKMSIdentity_basic(t *testing.T) {
+	suffix := acctest.RandString(8)
+	sum := sha256.Sum256([]byte(suffix))
+	identity := hex.EncodeToString(sum[:])
+	resourceName := "minio_kms_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckKMSKey(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioKMSIdentityConfig(suffix, identity, "reader"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identity", identity),
+					resource.TestCheckResourceAttr(resourceName, "policy", fmt.Sprintf("tfacc-reader-%s", suffix)),
+					resource.TestCheckResourceAttr(resourceName, "is_admin", "false"),
+				),
+			},
+			{
+				Config: testAccMinioKMSIdentityConfig(suffix, identity, "writer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy", fmt.Sprintf("tfacc-writer-%s", suffix)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMinioKMSIdentityConfig(suffix, identity, "writer") + fmt.Sprintf(`
+data "minio_kms_identities" "test" {
+  provider = kmsminio
+  pattern  = "%s"
+
+  depends_on = [minio_kms_identity.test]
+}
+`, identity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.minio_kms_identities.test", "identities.#", "1"),
+					resource.TestCheckResourceAttr("data.minio_kms_identities.test", "identities.0.identity", identity),
+				),
+			},
+		},
+	})
+}
+
+func testAccMinioKMSIdentityConfig(suffix, identity, policy string) string {
+	return fmt.Sprintf(`
+resource "minio_kms_policy" "reader" {
+  provider = kmsminio
+  name     = "tfacc-reader-%[1]s"
+  allow    = ["/v1/key/decrypt/*"]
+}
+
+resource "minio_kms_policy" "writer" {
+  provider = kmsminio
+  name     = "tfacc-writer-%[1]s"
+  allow    = ["/v1/key/generate/*", "/v1/key/decrypt/*"]
+}
+
+resource "minio_kms_identity" "test" {
+  provider = kmsminio
+  identity = "%[2]s"
+  policy   = minio_kms_policy.%[3]s.name
+}
+`, suffix, identity, policy)
+}
@@ -2,21 +2,27 @@ package minio
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/minio/madmin-go/v4"
 )
 
 func resourceMinioKMSKey() *schema.Resource {
 	return &schema.Resource{
+		Description: "Manages a key in the KMS configured on the MinIO server. The key is generated by the KMS or imported from existing key material. " +
+			"Its encryption and decryption self-test results are refreshed on every read, and planning fails if the key can no longer decrypt.",
 		CreateContext: minioCreateKMSKey,
 		ReadContext:   minioReadKMSKey,
 		DeleteContext: minioDeleteKMSKey,
+		CustomizeDiff: customizeDiffKMSKey,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				if err := d.Set("key_id", d.Id()); err != nil {
@@ -34,23 +40,101 @@ func resourceMinioKMSKey() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"key_material_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				RequiredWith: []string{"key_material_wo_version"},
+				ValidateFunc: validation.StringIsBase64,
+				Description:  "Base64-encoded 256-bit key material to import instead of letting the KMS generate the key. Only supported by KMS backends that allow importing keys. This is a write-only field and will not be stored in state.",
+			},
+			"key_material_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"key_material_wo"},
+				Description:  "Version of `key_material_wo`. Changing it re-imports the key.",
+			},
+			"encryption_ok": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key passed the KMS encryption self-test on the last refresh.",
+			},
+			"encryption_error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Error returned by the KMS encryption self-test, if any.",
+			},
+			"decryption_ok": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key passed the KMS decryption self-test on the last refresh. Planning fails while this is `false`.",
+			},
+			"decryption_error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Error returned by the KMS decryption self-test, if any.",
+			},
 		},
 	}
 }
 
+// customizeDiffKMSKey fails the plan when the last refresh found that the key
+// can no longer decrypt, since data encrypted with it would be unreadable.
+func customizeDiffKMSKey(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if msg := d.Get("decryption_error").(string); msg != "" {
+		return fmt.Errorf("KMS key %q failed the decryption self-test, data encrypted with it cannot be read: %s", d.Id(), msg)
+	}
+
+	if msg := d.Get("encryption_error").(string); msg != "" {
+		tflog.Warn(ctx, fmt.Sprintf("KMS key %q failed the encryption self-test: %s", d.Id(), msg))
+	}
+
+	return nil
+}
+
 func minioCreateKMSKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keyConfig := KMSKeyConfig(d, meta)
 
 	keyID := keyConfig.MinioKMSKeyID
 
-	if err := keyConfig.MinioAdmin.CreateKey(ctx, keyID); err != nil {
+	material, hasMaterial, err := getWriteOnlyStringAt(d, cty.GetAttrPath("key_material_wo"), "key_material_wo")
+	if err != nil {
+		return NewResourceError("reading key material", keyID, err)
+	}
+
+	if hasMaterial {
+		content, err := json.Marshal(map[string]string{"bytes": material})
+		if err != nil {
+			return NewResourceError("encoding key material", keyID, err)
+		}
+		if err := keyConfig.MinioAdmin.ImportKey(ctx, keyID, content); err != nil {
+			return NewResourceError("error importing KMS key", keyID, err)
+		}
+	} else if err := keyConfig.MinioAdmin.CreateKey(ctx, keyID); err != nil {
 		return NewResourceError("error creating KMS key", keyID, err)
 	}
 
 	d.SetId(keyID)
 	_ = d.Set("key_id", d.Id())
 
-	return minioReadKMSKey(ctx, d, meta)
+	if diags := minioReadKMSKey(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	if msg := d.Get("encryption_error").(string); msg != "" {
+		return NewResourceError("KMS key has encryption error", keyID, msg)
+	}
+	if msg := d.Get("decryption_error").(string); msg != "" {
+		return NewResourceError("KMS key has decryption error", keyID, msg)
+	}
+
+	return nil
 }
 
 func minioReadKMSKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -68,15 +152,15 @@ func minioReadKMSKey(ctx context.Context, d *schema.ResourceData, meta interface
 
 	tflog.Debug(ctx, fmt.Sprintf("KMS key [%s] exists!", keyConfig.MinioKMSKeyID))
 
-	if status.EncryptionErr != "" {
-		return NewResourceError("KMS key has encryption error", keyConfig.MinioKMSKeyID, status.EncryptionErr)
-	}
-
-	if status.DecryptionErr != "" {
-		return NewResourceError("KMS key has decryption error", keyConfig.MinioKMSKeyID, status.DecryptionErr)
+	if status.EncryptionErr != "" || status.DecryptionErr != "" {
+		tflog.Warn(ctx, fmt.Sprintf("KMS key [%s] failed self-test: encryption=%q decryption=%q", keyConfig.MinioKMSKeyID, status.EncryptionErr, status.DecryptionErr))
 	}
 
 	_ = d.Set("key_id", d.Id())
+	_ = d.Set("encryption_ok", status.EncryptionErr == "")
+	_ = d.Set("encryption_error", status.EncryptionErr)
+	_ = d.Set("decryption_ok", status.DecryptionErr == "")
+	_ = d.Set("decryption_error", status.DecryptionErr)
 
 	return nil
 }
@@ -60,6 +60,9 @@ func TestAccMinioKMSKey_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMinioKMSKeyExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "key_id", keyID),
+					resource.TestCheckResourceAttr(resourceName, "encryption_ok", "true"),
+					resource.TestCheckResourceAttr(resourceName, "decryption_ok", "true"),
+					resource.TestCheckResourceAttr(resourceName, "decryption_error", ""),
 				),
 			},
 			{
@@ -71,6 +74,35 @@ func TestAccMinioKMSKey_basic(t *testing.T) {
 	})
 }
 
+func TestAccMinioKMSKey_importKeyMaterial(t *testing.T) {
+	keyID := fmt.Sprintf("tfacc-kms-import-%d", acctest.RandInt())
+	resourceName := "minio_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckKMSKey(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckMinioKMSKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "minio_kms_key" "test" {
+  provider                = "kmsminio"
+  key_id                  = "%s"
+  key_material_wo         = "MzJieXRlc29mc2VjcmV0a2V5bWF0ZXJpYWwxMjM0NTY="
+  key_material_wo_version = 1
+}
+`, keyID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMinioKMSKeyExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "key_material_wo"),
+					resource.TestCheckResourceAttr(resourceName, "key_material_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "decryption_ok", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckMinioKMSKeyDestroy(s *terraform.State) error {
 	kms, err := testAccKmsClient()
 	if err != nil {
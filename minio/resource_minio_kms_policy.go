@@ -0,0 +1,166 @@
+package minio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/minio/madmin-go/v4"
+)
+
+func resourceMinioKMSPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a policy on the KMS (KES) configured on the MinIO server. " +
+			"A policy allows or denies KMS API paths, which include the key names they apply to (e.g. `/v1/key/generate/app-*`). " +
+			"Assign it to client identities with `minio_kms_identity`.",
+		CreateContext: minioCreateKMSPolicy,
+		ReadContext:   minioReadKMSPolicy,
+		UpdateContext: minioUpdateKMSPolicy,
+		DeleteContext: minioDeleteKMSPolicy,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the KMS policy.",
+			},
+			"allow": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateKMSAPIPath},
+				Description: "KMS API paths the policy allows, with optional `*` wildcards (e.g. `/v1/key/create/app-*`, `/v1/key/generate/app-*`).",
+			},
+			"deny": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateKMSAPIPath},
+				Description: "KMS API paths the policy denies. Deny rules take precedence over allow rules.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the policy was created.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity that created the policy.",
+			},
+		},
+	}
+}
+
+func validateKMSAPIPath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must be a KMS API path starting with \"/\" (e.g. \"/v1/key/create/*\"), got %q", k, value))
+	}
+	return
+}
+
+func minioCreateKMSPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	if diags := putKMSPolicy(ctx, d, meta, name); diags != nil {
+		return diags
+	}
+
+	d.SetId(name)
+
+	return minioReadKMSPolicy(ctx, d, meta)
+}
+
+func minioUpdateKMSPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := putKMSPolicy(ctx, d, meta, d.Id()); diags != nil {
+		return diags
+	}
+
+	return minioReadKMSPolicy(ctx, d, meta)
+}
+
+func putKMSPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	policy := madmin.KMSPolicy{
+		Allow: getStringList(d.Get("allow").(*schema.Set).List()),
+		Deny:  getStringList(d.Get("deny").(*schema.Set).List()),
+	}
+	content, err := json.Marshal(policy)
+	if err != nil {
+		return NewResourceError("encoding KMS policy", name, err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting KMS policy [%s]", name))
+
+	if err := admin.SetKMSPolicy(ctx, name, content); err != nil {
+		return NewResourceError("error setting KMS policy", name, err)
+	}
+
+	return nil
+}
+
+func minioReadKMSPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	name := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading KMS policy [%s]", name))
+
+	policy, err := admin.GetPolicy(ctx, name)
+	if err != nil {
+		if isKMSNotFoundError(err) {
+			tflog.Warn(ctx, fmt.Sprintf("KMS policy [%s] not found, removing from state", name))
+			d.SetId("")
+			return nil
+		}
+		return NewResourceError("error reading KMS policy", name, err)
+	}
+
+	_ = d.Set("name", name)
+	if err := d.Set("allow", policy.Allow); err != nil {
+		return NewResourceError("setting allow", name, err)
+	}
+	if err := d.Set("deny", policy.Deny); err != nil {
+		return NewResourceError("setting deny", name, err)
+	}
+
+	if info, err := admin.DescribePolicy(ctx, name); err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Could not describe KMS policy [%s]: %v", name, err))
+	} else {
+		_ = d.Set("created_at", info.CreatedAt)
+		_ = d.Set("created_by", info.CreatedBy)
+	}
+
+	return nil
+}
+
+func minioDeleteKMSPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting KMS policy [%s]", d.Id()))
+
+	if err := admin.DeletePolicy(ctx, d.Id()); err != nil && !isKMSNotFoundError(err) {
+		return NewResourceError("unable to remove KMS policy", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isKMSNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "not found") ||
+		strings.Contains(msg, "does not exist")
+}
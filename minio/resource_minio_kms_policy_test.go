@@ -0,0 +1,88 @@
+package minio
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMinioKMSPolicy_basic(t *testing.T) {
+	name := fmt.Sprintf("tfacc-kms-policy-%d", acctest.RandInt())
+	resourceName := "minio_kms_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckKMSKey(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioKMSPolicyConfig(name, `["/v1/key/create/app-*", "/v1/key/generate/app-*"]`, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "allow.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allow.*", "/v1/key/create/app-*"),
+					resource.TestCheckResourceAttr(resourceName, "deny.#", "0"),
+				),
+			},
+			{
+				Config: testAccMinioKMSPolicyConfig(name, `["/v1/key/generate/app-*"]`, `["/v1/key/delete/*"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allow.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "deny.*", "/v1/key/delete/*"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataSourceMinioKMSPolicies_basic(t *testing.T) {
+	name := fmt.Sprintf("tfacc-kms-policies-%d", acctest.RandInt())
+	const dataName = "data.minio_kms_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckKMSKey(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioKMSPolicyConfig(name, `["/v1/key/generate/*"]`, `[]`) + fmt.Sprintf(`
+data "minio_kms_policies" "test" {
+  provider = kmsminio
+  pattern  = "%s"
+
+  depends_on = [minio_kms_policy.test]
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(dataName, "policies.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateKMSAPIPath(t *testing.T) {
+	if _, errs := validateKMSAPIPath("/v1/key/create/*", "allow"); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	if _, errs := validateKMSAPIPath("v1/key/create/*", "allow"); len(errs) == 0 {
+		t.Error("expected error for path without leading slash")
+	}
+}
+
+func testAccMinioKMSPolicyConfig(name, allow, deny string) string {
+	return fmt.Sprintf(`
+resource "minio_kms_policy" "test" {
+  provider = kmsminio
+  name     = "%s"
+  allow    = %s
+  deny     = %s
+}
+`, name, allow, deny)
+}
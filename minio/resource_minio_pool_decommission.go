@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/minio/madmin-go/v4"
 )
@@ -24,14 +25,21 @@ const (
 func resourceMinioPoolDecommission() *schema.Resource {
 	return &schema.Resource{
 		Description: "Decommissions a storage pool from a MinIO cluster. WARNING: this is a destructive and irreversible operation — once decommission completes, MinIO removes the pool and the data on it cannot be recovered via Terraform. Ensure all data has been migrated before applying.\n\n" +
-			"Create returns as soon as MinIO accepts the request; the decommission then runs asynchronously on the server. Refresh the resource (or read the `minio_pool_status` data source) to observe progress in the `state` and `status` attributes.\n\n" +
+			"By default, Create returns as soon as MinIO accepts the request; the decommission then runs asynchronously on the server. Refresh the resource (or read the `minio_pool_status` data source) to observe progress in the `state` and `status` attributes, or set `wait_for_completion` to wait for the decommission within the `create` timeout.\n\n" +
 			"Destroying the resource cancels an in-progress decommission (no-op once the pool has already been removed).",
 		CreateContext: minioCreatePoolDecommission,
 		ReadContext:   minioReadPoolDecommission,
+		UpdateContext: minioReadPoolDecommission,
 		DeleteContext: minioDeletePoolDecommission,
 		Importer: &schema.ResourceImporter{
 			StateContext: minioImportPoolDecommission,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"pool_index": {
 				Type:        schema.TypeInt,
@@ -39,6 +47,12 @@ func resourceMinioPoolDecommission() *schema.Resource {
 				ForceNew:    true,
 				Description: "Pool ID to decommission, as reported by the `index` attribute of the `minio_pool_status` data source.",
 			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait during Create until the decommission completes, at most for the `create` timeout. A failed or canceled decommission is reported as an error. When the timeout is reached, the decommission keeps running and the resource is saved to state with a warning. Defaults to `false`.",
+			},
 			"started_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -88,9 +102,47 @@ func minioCreatePoolDecommission(ctx context.Context, d *schema.ResourceData, me
 
 	tflog.Debug(ctx, fmt.Sprintf("Decommission started for pool index %d at %s", poolIndex, now))
 
+	if d.Get("wait_for_completion").(bool) {
+		if err := waitForPoolDecommission(ctx, admin, poolIndex); err != nil {
+			if waitTimedOut(err) {
+				return waitTimeoutWarning(ctx, d, meta, fmt.Sprintf("decommission of pool index %d", poolIndex), err, minioReadPoolDecommission)
+			}
+			return NewResourceError("waiting for decommission", d.Id(), err)
+		}
+	}
+
 	return minioReadPoolDecommission(ctx, d, meta)
 }
 
+// waitForPoolDecommission polls the pool until its decommission completes,
+// which MinIO may report by removing the pool, until the context deadline.
+func waitForPoolDecommission(ctx context.Context, admin *madmin.AdminClient, poolIndex int) error {
+	return retry.RetryContext(ctx, remainingTimeout(ctx, 60*time.Minute), func() *retry.RetryError {
+		pools, err := admin.ListPoolsStatus(ctx)
+		if err != nil {
+			return retry.RetryableError(fmt.Errorf("listing pools: %w", err))
+		}
+		poolEndpoint, err := findPoolEndpointByID(pools, poolIndex)
+		if err != nil {
+			return nil
+		}
+		poolStatus, err := admin.StatusPool(ctx, poolEndpoint)
+		if err != nil {
+			return retry.RetryableError(fmt.Errorf("reading pool status: %w", err))
+		}
+
+		switch state := decommissionState(poolStatus.Decommission); state {
+		case poolStateDecommissioned:
+			return nil
+		case poolStateDecommissioning:
+			tflog.Debug(ctx, fmt.Sprintf("Pool index %d is still decommissioning", poolIndex))
+			return retry.RetryableError(fmt.Errorf("pool index %d is still decommissioning", poolIndex))
+		default:
+			return operationFailed(fmt.Errorf("decommission of pool index %d is %s", poolIndex, state))
+		}
+	})
+}
+
 func minioReadPoolDecommission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	admin := meta.(*S3MinioClient).S3Admin
 
@@ -170,7 +222,7 @@ func minioDeletePoolDecommission(ctx context.Context, d *schema.ResourceData, me
 		if isDecommissionCancelError(err) {
 			tflog.Debug(ctx, fmt.Sprintf("Decommission already complete or not in progress for pool %d: %v", poolIndex, err))
 		} else {
-			return NewResourceError("cancelling decommission", d.Id(), err)
+			return NewResourceError("cancelling decommission", d.Id(), timeoutHint(ctx, err, schema.TimeoutDelete))
 		}
 	}
 
@@ -198,6 +250,9 @@ func minioImportPoolDecommission(ctx context.Context, d *schema.ResourceData, me
 	if err := d.Set("pool_index", poolIndex); err != nil {
 		return nil, err
 	}
+	if err := d.Set("wait_for_completion", false); err != nil {
+		return nil, err
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
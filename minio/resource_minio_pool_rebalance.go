@@ -9,16 +9,24 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/minio/madmin-go/v4"
 )
 
 func resourceMinioPoolRebalance() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Starts a MinIO storage pool rebalance operation. Destroying the resource stops the rebalance. Only one rebalance can be in progress per cluster.",
+		Description:   "Starts a MinIO storage pool rebalance operation. Destroying the resource stops the rebalance. Only one rebalance can be in progress per cluster. Set `wait_for_completion` to wait for the rebalance within the `create` timeout.",
 		CreateContext: minioCreatePoolRebalance,
 		ReadContext:   minioReadPoolRebalance,
+		UpdateContext: minioReadPoolRebalance,
 		DeleteContext: minioDeletePoolRebalance,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"triggers": {
 				Type:        schema.TypeMap,
@@ -27,6 +35,12 @@ func resourceMinioPoolRebalance() *schema.Resource {
 				Description: "Map of arbitrary strings that, when changed, will force re-creation of the resource.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait during Create until every pool has finished rebalancing, at most for the `create` timeout. A failed or stopped rebalance is reported as an error. When the timeout is reached, the rebalance keeps running and the resource is saved to state with a warning. Defaults to `false`.",
+			},
 			"started_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -61,9 +75,56 @@ func minioCreatePoolRebalance(ctx context.Context, d *schema.ResourceData, meta
 
 	tflog.Debug(ctx, fmt.Sprintf("Pool rebalance started with ID %s", rebalanceID))
 
+	if d.Get("wait_for_completion").(bool) {
+		if err := waitForPoolRebalance(ctx, admin); err != nil {
+			if waitTimedOut(err) {
+				return waitTimeoutWarning(ctx, d, meta, "pool rebalance", err, minioReadPoolRebalance)
+			}
+			return NewResourceError("waiting for pool rebalance", d.Id(), err)
+		}
+	}
+
 	return minioReadPoolRebalance(ctx, d, meta)
 }
 
+// waitForPoolRebalance polls the rebalance status until the context deadline.
+func waitForPoolRebalance(ctx context.Context, admin *madmin.AdminClient) error {
+	return retry.RetryContext(ctx, remainingTimeout(ctx, 60*time.Minute), func() *retry.RetryError {
+		status, err := admin.RebalanceStatus(ctx)
+		if err != nil {
+			if isRebalanceNotFoundError(err) {
+				return operationFailed(fmt.Errorf("rebalance was stopped"))
+			}
+			return retry.RetryableError(fmt.Errorf("reading rebalance status: %w", err))
+		}
+
+		done, err := rebalanceDone(status)
+		switch {
+		case err != nil:
+			return operationFailed(err)
+		case !done:
+			tflog.Debug(ctx, fmt.Sprintf("Pool rebalance %s is still running", status.ID))
+			return retry.RetryableError(fmt.Errorf("rebalance %s is still running", status.ID))
+		}
+		return nil
+	})
+}
+
+// rebalanceDone reports whether no pool is rebalancing anymore, and returns
+// an error when a pool failed or was stopped before completing.
+func rebalanceDone(status madmin.RebalanceStatus) (bool, error) {
+	done := true
+	for _, pool := range status.Pools {
+		switch pool.Status {
+		case "Started":
+			done = false
+		case "Failed", "Stopped":
+			return false, fmt.Errorf("rebalance of pool %d is %s", pool.ID, strings.ToLower(pool.Status))
+		}
+	}
+	return done, nil
+}
+
 func minioReadPoolRebalance(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	admin := meta.(*S3MinioClient).S3Admin
 
@@ -104,7 +165,7 @@ func minioDeletePoolRebalance(ctx context.Context, d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return NewResourceError("stopping pool rebalance", d.Id(), err)
+		return NewResourceError("stopping pool rebalance", d.Id(), timeoutHint(ctx, err, schema.TimeoutDelete))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Pool rebalance stopped (ID: %s)", d.Id()))
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/minio/madmin-go/v4"
 )
 
 // TestAccMinioPoolRebalance_basic tests the pool rebalance resource.
@@ -35,3 +36,42 @@ func TestAccMinioPoolRebalance_basic(t *testing.T) {
 const testAccMinioPoolRebalanceConfig = `
 resource "minio_pool_rebalance" "test" {}
 `
+
+func TestRebalanceDone(t *testing.T) {
+	t.Parallel()
+
+	pools := func(statuses ...string) madmin.RebalanceStatus {
+		status := madmin.RebalanceStatus{ID: "rebalance"}
+		for i, s := range statuses {
+			status.Pools = append(status.Pools, madmin.RebalancePoolStatus{ID: i, Status: s})
+		}
+		return status
+	}
+
+	cases := []struct {
+		name     string
+		status   madmin.RebalanceStatus
+		wantDone bool
+		wantErr  bool
+	}{
+		{name: "no_pools", status: pools(), wantDone: true},
+		{name: "running", status: pools("Started", "Completed"), wantDone: false},
+		{name: "completed", status: pools("Completed", "None"), wantDone: true},
+		{name: "failed", status: pools("Started", "Failed"), wantErr: true},
+		{name: "stopped", status: pools("Stopped", "Completed"), wantErr: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			done, err := rebalanceDone(tc.status)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("rebalanceDone() error = %v, want error %v", err, tc.wantErr)
+			}
+			if done != tc.wantDone {
+				t.Fatalf("rebalanceDone() = %v, want %v", done, tc.wantDone)
+			}
+		})
+	}
+}
@@ -59,6 +59,13 @@ func resourceMinioBucket() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceMinioS3BucketImportState,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Identity: stringIdentity("bucket", "Name of the bucket."),
 
 		SchemaVersion: 0,
 
@@ -125,11 +132,16 @@ func resourceMinioBucket() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "A map of tags to assign to the bucket",
 			},
+			"tags_all": tagsAllSchema("bucket"),
 		},
 	}
 }
 
 func customizeBucketDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffTagsAll(ctx, d, meta); err != nil {
+		return err
+	}
+
 	if d.Id() == "" {
 		return nil
 	}
@@ -231,7 +243,7 @@ func minioCreateBucket(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	tflog.Debug(ctx, fmt.Sprintf("Created bucket: [%s] in region: [%s]", bucket, region))
 
-	if diagErr := applyInitialBucketTags(ctx, d, bucketConfig, bucket, waitTimeout); diagErr != nil {
+	if diagErr := applyInitialBucketTags(ctx, d, meta, bucketConfig, bucket, waitTimeout); diagErr != nil {
 		return diagErr
 	}
 
@@ -258,22 +270,22 @@ func applyInitialBucketACL(ctx context.Context, bucketConfig *S3MinioBucket, buc
 	return nil
 }
 
-// applyInitialBucketTags sets the configured tags right after creation, retrying
-// while the bucket is not yet visible to the backend (eventual consistency). It is
-// a no-op when tagging is disabled for the provider or no tags are configured.
-func applyInitialBucketTags(ctx context.Context, d *schema.ResourceData, bucketConfig *S3MinioBucket, bucket string, waitTimeout time.Duration) diag.Diagnostics {
+// applyInitialBucketTags sets the configured tags, merged over the provider default
+// tags, right after creation, retrying while the bucket is not yet visible to the
+// backend (eventual consistency). It is a no-op when tagging is disabled for the
+// provider or there are no tags.
+func applyInitialBucketTags(ctx context.Context, d *schema.ResourceData, meta interface{}, bucketConfig *S3MinioBucket, bucket string, waitTimeout time.Duration) diag.Diagnostics {
 	if shouldSkipBucketTagging(bucketConfig) {
 		tflog.Info(ctx, fmt.Sprintf("Bucket [%s] tagging is disabled for this provider configuration; skipping tag creation", bucket))
 		return nil
 	}
 
-	v, ok := d.GetOk("tags")
-	if !ok {
+	tagsAll := resourceTagsAll(d, meta)
+	if len(tagsAll) == 0 {
 		return nil
 	}
 
-	tagsMap := v.(map[string]interface{})
-	bucketTags, err := tags.NewTags(convertToStringMap(tagsMap), false)
+	bucketTags, err := tags.NewTags(tagsAll, false)
 	if err != nil {
 		return NewResourceError("error creating bucket tags", bucket, err)
 	}
@@ -320,13 +332,17 @@ func minioReadBucket(ctx context.Context, d *schema.ResourceData, meta interface
 		_ = d.Set("bucket", d.Id())
 	}
 
+	if err := setStringIdentity(d, "bucket"); err != nil {
+		return NewResourceError("error setting bucket identity", d.Id(), err)
+	}
+
 	bucketURL := bucketConfig.MinioClient.EndpointURL()
 
 	_ = d.Set("arn", bucketArn(d.Id()))
 	_ = d.Set("bucket_domain_name", bucketDomainName(d.Id(), bucketURL))
 
 	if shouldSkipBucketTagging(bucketConfig) {
-		preserveBucketTagsState(d)
+		preserveBucketTagsState(d, meta)
 		return nil
 	}
 
@@ -334,15 +350,15 @@ func minioReadBucket(ctx context.Context, d *schema.ResourceData, meta interface
 	if err != nil {
 		var minioErr minio.ErrorResponse
 		if errors.As(err, &minioErr) && minioErr.Code == "NoSuchTagSet" {
-			_ = d.Set("tags", map[string]string{})
+			_ = setResourceTags(d, meta, map[string]string{})
 		} else if IsS3TaggingNotImplemented(err) {
 			tflog.Info(ctx, "Bucket tagging is not supported by backend; preserving state")
-			preserveBucketTagsState(d)
+			preserveBucketTagsState(d, meta)
 		} else {
 			return NewResourceError("error reading bucket tags", d.Id(), err)
 		}
 	} else {
-		_ = d.Set("tags", bucketTags.ToMap())
+		_ = setResourceTags(d, meta, bucketTags.ToMap())
 	}
 
 	return nil
@@ -415,10 +431,9 @@ func minioUpdateBucket(ctx context.Context, d *schema.ResourceData, meta interfa
 		_ = d.Set("acl", bucketConfig.MinioACL)
 	}
 
-	if d.HasChange("tags") && !shouldSkipBucketTagging(bucketConfig) {
-		if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
-			tagsMap := v.(map[string]interface{})
-			bucketTags, err := tags.NewTags(convertToStringMap(tagsMap), false)
+	if d.HasChanges("tags", "tags_all") && !shouldSkipBucketTagging(bucketConfig) {
+		if tagsAll := resourceTagsAll(d, meta); len(tagsAll) > 0 {
+			bucketTags, err := tags.NewTags(tagsAll, false)
 			if err != nil {
 				return NewResourceError("error creating bucket tags", d.Id(), err)
 			}
@@ -477,7 +492,7 @@ func minioDeleteBucket(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 
 		if diagErr := forceDestroyBucketObjects(ctx, bucketConfig.MinioClient, bucketName); diagErr != nil {
-			return diagErr
+			return timeoutHintDiags(ctx, diagErr, schema.TimeoutDelete)
 		}
 	}
 
@@ -487,7 +502,7 @@ func minioDeleteBucket(ctx context.Context, d *schema.ResourceData, meta interfa
 			return nil
 		}
 		tflog.Error(ctx, NewResourceErrorStr("unable to remove bucket", bucketName, err))
-		return NewResourceError("unable to remove bucket", bucketName, err)
+		return NewResourceError("unable to remove bucket", bucketName, timeoutHint(ctx, err, schema.TimeoutDelete))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleted bucket: [%s] in region: [%s]", bucketName, bucketConfig.MinioRegion))
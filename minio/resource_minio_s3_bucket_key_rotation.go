@@ -0,0 +1,313 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/sse"
+)
+
+const kmsKeyARNPrefix = "arn:aws:kms:"
+
+func resourceMinioS3BucketKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Rotates the SSE-KMS key of a bucket. Switches the bucket's default encryption to `kms_key_id`, " +
+			"then runs a `keyrotate` batch job that re-encrypts existing objects and waits for it to complete. " +
+			"Fails if the job reports failures or if matching objects are still encrypted with the previous key. " +
+			"Destroying this resource only removes it from Terraform state.",
+
+		CreateContext: minioCreateBucketKeyRotation,
+		ReadContext:   minioReadBucketKeyRotation,
+		DeleteContext: minioDeleteBucketKeyRotation,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+				Description:  "Bucket whose key is rotated.",
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "KMS key the bucket and its objects are switched to.",
+			},
+			"kms_context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "KMS encryption context used when re-encrypting objects.",
+			},
+			"previous_kms_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "KMS key objects are rotated away from. Defaults to the bucket's default key before the rotation. When known, only objects encrypted with this key are rotated.",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Only rotate objects under this prefix.",
+			},
+			"older_than": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyRotationAge,
+				Description:  "Only rotate objects older than this age (e.g. `30d`, `7d12h`).",
+			},
+			"newer_than": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKeyRotationAge,
+				Description:  "Only rotate objects newer than this age (e.g. `30d`, `7d12h`).",
+			},
+			"verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "After the job completes, check every matching object and fail if any is still encrypted with the previous key. Requires one metadata request per object. Defaults to `true`.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of strings to run the rotation again when changed.",
+			},
+			"job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the keyrotate batch job.",
+			},
+			"objects_rotated": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects re-encrypted by the batch job.",
+			},
+			"objects_failed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects the batch job failed to re-encrypt.",
+			},
+			"objects_remaining": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of matching objects still encrypted with the previous key after the job. Always 0 on success when `verify` is enabled.",
+			},
+		},
+	}
+}
+
+func minioCreateBucketKeyRotation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*S3MinioClient)
+	bucket := d.Get("bucket").(string)
+	newKey := normalizeKMSKeyID(d.Get("kms_key_id").(string))
+
+	previousKey := normalizeKMSKeyID(d.Get("previous_kms_key_id").(string))
+	if previousKey == "" {
+		current, err := client.S3Client.GetBucketEncryption(ctx, bucket)
+		if err != nil && minio.ToErrorResponse(err).Code != "ServerSideEncryptionConfigurationNotFoundError" {
+			return NewResourceError("reading bucket encryption", bucket, err)
+		}
+		if current != nil && len(current.Rules) > 0 {
+			previousKey = normalizeKMSKeyID(current.Rules[0].Apply.KmsMasterKeyID)
+		}
+	}
+	if previousKey == newKey {
+		previousKey = ""
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Rotating bucket %s from key %q to %q", bucket, previousKey, newKey))
+
+	if err := client.S3Client.SetBucketEncryption(ctx, bucket, sse.NewConfigurationSSEKMS(newKey)); err != nil {
+		return NewResourceError("setting bucket default encryption", bucket, err)
+	}
+
+	block := map[string]interface{}{
+		"bucket":          bucket,
+		"prefix":          d.Get("prefix").(string),
+		"encryption_type": "sse-kms",
+		"kms_key_id":      newKey,
+		"kms_context":     d.Get("kms_context").(string),
+		"filter": []interface{}{map[string]interface{}{
+			"newer_than":     d.Get("newer_than").(string),
+			"older_than":     d.Get("older_than").(string),
+			"created_after":  "",
+			"created_before": "",
+			"tags":           []interface{}{},
+			"metadata":       []interface{}{},
+			"kms_key":        previousKey,
+		}},
+		"notify": []interface{}{},
+		"retry":  []interface{}{},
+	}
+
+	apiVersion := ""
+	if tmpl, _, err := fetchBatchJobTemplate(ctx, client.S3Admin, "keyrotate"); err == nil {
+		apiVersion = batchJobTemplateAPIVersion(tmpl, "keyrotate")
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("Could not fetch keyrotate template, using default apiVersion: %v", err))
+	}
+
+	jobYAML, err := renderBatchJobYAML("keyrotate", block, apiVersion, batchJobSecrets{})
+	if err != nil {
+		return NewResourceError("rendering keyrotate job", bucket, err)
+	}
+
+	result, err := client.S3Admin.StartBatchJob(ctx, jobYAML)
+	if err != nil {
+		return NewResourceError("starting keyrotate job", bucket, err)
+	}
+
+	d.SetId(result.ID)
+	_ = d.Set("job_id", result.ID)
+	_ = d.Set("previous_kms_key_id", previousKey)
+
+	if diags := waitForBatchJobStatus(ctx, &S3MinioBatchJob{MinioAdmin: client.S3Admin}, result.ID, "completed", d.Timeout(schema.TimeoutCreate)); diags != nil {
+		return diags
+	}
+
+	status, err := client.S3Admin.BatchJobStatus(ctx, result.ID)
+	if err != nil {
+		return NewResourceError("reading keyrotate job status", result.ID, err)
+	}
+	progress := flattenBatchJobProgress(status)
+	_ = d.Set("objects_rotated", progress["objects"])
+	_ = d.Set("objects_failed", progress["objects_failed"])
+
+	if failed := progress["objects_failed"].(int); failed > 0 {
+		return NewResourceError("rotating bucket key", bucket, fmt.Errorf("keyrotate job %s failed to re-encrypt %d objects", result.ID, failed))
+	}
+
+	remaining := 0
+	if d.Get("verify").(bool) {
+		remaining, err = countObjectsNotRotated(ctx, client.S3Client, bucket, d, newKey, previousKey)
+		if err != nil {
+			return NewResourceError("verifying bucket key rotation", bucket, err)
+		}
+	}
+	_ = d.Set("objects_remaining", remaining)
+
+	if remaining > 0 {
+		return NewResourceError("rotating bucket key", bucket, fmt.Errorf("%d objects are still encrypted with the previous key after job %s", remaining, result.ID))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Rotated bucket %s to key %q: %d objects re-encrypted", bucket, newKey, progress["objects"]))
+
+	return nil
+}
+
+// countObjectsNotRotated counts the objects matched by the rotation filters
+// that are still encrypted with the previous key. When the previous key is
+// unknown, any SSE-KMS object not using the new key is counted.
+func countObjectsNotRotated(ctx context.Context, client *minio.Client, bucket string, d *schema.ResourceData, newKey, previousKey string) (int, error) {
+	now := time.Now()
+	olderThan, _ := parseKeyRotationAge(d.Get("older_than").(string))
+	newerThan, _ := parseKeyRotationAge(d.Get("newer_than").(string))
+
+	remaining := 0
+	for object := range client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: d.Get("prefix").(string), Recursive: true}) {
+		if object.Err != nil {
+			return 0, object.Err
+		}
+		if !keyRotationAgeMatches(now.Sub(object.LastModified), olderThan, newerThan) {
+			continue
+		}
+
+		info, err := client.StatObject(ctx, bucket, object.Key, minio.StatObjectOptions{})
+		if err != nil {
+			return 0, fmt.Errorf("reading %s: %w", object.Key, err)
+		}
+		keyID := normalizeKMSKeyID(info.Metadata.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"))
+		if keyID == "" {
+			continue
+		}
+		if (previousKey != "" && keyID == previousKey) || (previousKey == "" && keyID != newKey) {
+			remaining++
+		}
+	}
+
+	return remaining, nil
+}
+
+func normalizeKMSKeyID(keyID string) string {
+	return strings.TrimPrefix(strings.TrimSpace(keyID), kmsKeyARNPrefix)
+}
+
+// parseKeyRotationAge parses the ages accepted by batch job filters, which
+// extend Go durations with a leading day component (e.g. "7d12h").
+func parseKeyRotationAge(age string) (time.Duration, error) {
+	if age == "" {
+		return 0, nil
+	}
+
+	var total time.Duration
+	rest := age
+	if days, after, found := strings.Cut(age, "d"); found {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid day component in %q", age)
+		}
+		total = time.Duration(n) * 24 * time.Hour
+		rest = after
+	}
+
+	if rest != "" {
+		dur, err := time.ParseDuration(rest)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age %q: %w", age, err)
+		}
+		total += dur
+	}
+
+	return total, nil
+}
+
+func validateKeyRotationAge(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseKeyRotationAge(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an age such as \"30d\" or \"7d12h\": %w", k, err))
+	}
+	return
+}
+
+// keyRotationAgeMatches reports whether an object of the given age is matched
+// by the older_than/newer_than filters. Zero filters match everything.
+func keyRotationAgeMatches(age, olderThan, newerThan time.Duration) bool {
+	if olderThan > 0 && age <= olderThan {
+		return false
+	}
+	if newerThan > 0 && age >= newerThan {
+		return false
+	}
+	return true
+}
+
+func minioReadBucketKeyRotation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading bucket key rotation (no-op): %s", d.Id()))
+	return nil
+}
+
+func minioDeleteBucketKeyRotation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting bucket key rotation (state-only, no API call): %s", d.Id()))
+	d.SetId("")
+	return nil
+}
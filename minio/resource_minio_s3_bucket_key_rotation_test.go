@@ -0,0 +1,129 @@
+package minio
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMinioS3BucketKeyRotation_basic(t *testing.T) {
+	suffix := acctest.RandString(8)
+	resourceName := "minio_s3_bucket_key_rotation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckKMSKey(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioS3BucketKeyRotationConfig(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "previous_kms_key_id", "tfacc-rotate-old-"+suffix),
+					resource.TestCheckResourceAttr(resourceName, "objects_rotated", "1"),
+					resource.TestCheckResourceAttr(resourceName, "objects_failed", "0"),
+					resource.TestCheckResourceAttr(resourceName, "objects_remaining", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMinioS3BucketKeyRotationConfig(suffix string) string {
+	return fmt.Sprintf(`
+resource "minio_kms_key" "old" {
+  provider = kmsminio
+  key_id   = "tfacc-rotate-old-%[1]s"
+}
+
+resource "minio_kms_key" "new" {
+  provider = kmsminio
+  key_id   = "tfacc-rotate-new-%[1]s"
+}
+
+resource "minio_s3_bucket" "bucket" {
+  provider = kmsminio
+  bucket   = "tfacc-rotate-%[1]s"
+}
+
+resource "minio_s3_bucket_server_side_encryption" "sse" {
+  provider        = kmsminio
+  bucket          = minio_s3_bucket.bucket.id
+  encryption_type = "aws:kms"
+  kms_key_id      = minio_kms_key.old.key_id
+
+  lifecycle {
+    ignore_changes = [kms_key_id]
+  }
+}
+
+resource "minio_s3_object" "object" {
+  provider    = kmsminio
+  bucket_name = minio_s3_bucket.bucket.id
+  object_name = "data/object.txt"
+  content     = "rotate me"
+
+  depends_on = [minio_s3_bucket_server_side_encryption.sse]
+}
+
+resource "minio_s3_bucket_key_rotation" "test" {
+  provider   = kmsminio
+  bucket     = minio_s3_bucket.bucket.id
+  kms_key_id = minio_kms_key.new.key_id
+
+  depends_on = [minio_s3_object.object]
+}
+`, suffix)
+}
+
+func TestParseKeyRotationAge(t *testing.T) {
+	cases := map[string]time.Duration{
+		"":      0,
+		"30d":   30 * 24 * time.Hour,
+		"7d12h": 7*24*time.Hour + 12*time.Hour,
+		"90m":   90 * time.Minute,
+	}
+	for in, want := range cases {
+		got, err := parseKeyRotationAge(in)
+		if err != nil {
+			t.Errorf("parseKeyRotationAge(%q) error = %v", in, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("parseKeyRotationAge(%q) = %v, want %v", in, got, want)
+		}
+	}
+
+	for _, in := range []string{"d", "xd", "7days", "soon"} {
+		if _, err := parseKeyRotationAge(in); err == nil {
+			t.Errorf("parseKeyRotationAge(%q) expected error", in)
+		}
+	}
+}
+
+func TestKeyRotationAgeMatches(t *testing.T) {
+	day := 24 * time.Hour
+	if !keyRotationAgeMatches(10*day, 0, 0) {
+		t.Error("no filters should match every object")
+	}
+	if keyRotationAgeMatches(10*day, 30*day, 0) {
+		t.Error("a 10 day old object is not older than 30 days")
+	}
+	if !keyRotationAgeMatches(40*day, 30*day, 0) {
+		t.Error("a 40 day old object is older than 30 days")
+	}
+	if keyRotationAgeMatches(40*day, 0, 30*day) {
+		t.Error("a 40 day old object is not newer than 30 days")
+	}
+}
+
+func TestNormalizeKMSKeyID(t *testing.T) {
+	if got := normalizeKMSKeyID("arn:aws:kms:my-key"); got != "my-key" {
+		t.Errorf("normalizeKMSKeyID() = %q, want my-key", got)
+	}
+	if got := normalizeKMSKeyID("my-key"); got != "my-key" {
+		t.Errorf("normalizeKMSKeyID() = %q, want my-key", got)
+	}
+}
@@ -275,7 +275,7 @@ func lifecycleAbortSchema() map[string]*schema.Schema {
 	}
 }
 
-func customizeDiffS3BucketLifecycle(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+func customizeDiffS3BucketLifecycle(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	rulesRaw, ok := d.Get("rule").([]interface{})
 	if !ok {
 		return nil
@@ -297,9 +297,66 @@ func customizeDiffS3BucketLifecycle(_ context.Context, d *schema.ResourceDiff, _
 			return err
 		}
 	}
+
+	if validateAgainstServer(meta) && d.HasChange("rule") {
+		return validateLifecycleTiers(ctx, meta, rulesRaw)
+	}
+	return nil
+}
+
+// validateLifecycleTiers checks that the transitions of the rules target a
+// tier configured on the server, as MinIO requires.
+func validateLifecycleTiers(ctx context.Context, meta interface{}, rules []interface{}) error {
+	classes := lifecycleTransitionStorageClasses(rules)
+	if len(classes) == 0 {
+		return nil
+	}
+
+	tiers, err := meta.(*S3MinioClient).S3Admin.ListTiers(ctx)
+	if err != nil {
+		return serverValidationUnavailable(ctx, meta, "the lifecycle transitions", err)
+	}
+	configured := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		configured[tier.Name] = true
+	}
+
+	for _, class := range classes {
+		if !configured[class] {
+			return fmt.Errorf("transition storage_class %q is not a tier configured on the server", class)
+		}
+	}
 	return nil
 }
 
+// lifecycleTransitionStorageClasses returns the distinct storage classes the
+// rules transition objects to.
+func lifecycleTransitionStorageClasses(rules []interface{}) []string {
+	var classes []string
+	seen := make(map[string]bool)
+	for _, ri := range rules {
+		rule, ok := ri.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"transition", "noncurrent_version_transition"} {
+			blocks, _ := rule[key].([]interface{})
+			if len(blocks) == 0 {
+				continue
+			}
+			block, ok := blocks[0].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if class, _ := block["storage_class"].(string); class != "" && !seen[class] {
+				seen[class] = true
+				classes = append(classes, class)
+			}
+		}
+	}
+	return classes
+}
+
 func validateLifecycleRule(id string, rule map[string]interface{}) error {
 	if id == "" {
 		id = "<unknown>"
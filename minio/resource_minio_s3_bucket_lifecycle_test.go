@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -1093,3 +1094,28 @@ resource "minio_s3_bucket_lifecycle" "test" {
 }
 `, bucket)
 }
+
+func TestLifecycleTransitionStorageClasses(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"id":         "archive",
+			"transition": []interface{}{map[string]interface{}{"days": 30, "storage_class": "COLD"}},
+			"noncurrent_version_transition": []interface{}{
+				map[string]interface{}{"noncurrent_days": 7, "storage_class": "WARM"},
+			},
+		},
+		map[string]interface{}{
+			"id":         "archive-logs",
+			"transition": []interface{}{map[string]interface{}{"days": 1, "storage_class": "COLD"}},
+		},
+		map[string]interface{}{
+			"id":         "expire",
+			"expiration": []interface{}{map[string]interface{}{"days": 90}},
+		},
+	}
+
+	got := lifecycleTransitionStorageClasses(rules)
+	if want := []string{"COLD", "WARM"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("lifecycleTransitionStorageClasses() = %v, want %v", got, want)
+	}
+}
@@ -31,9 +31,18 @@ func resourceMinioBucketReplication() *schema.Resource {
 		ReadContext:   minioReadBucketReplication,
 		UpdateContext: minioPutBucketReplication,
 		DeleteContext: minioDeleteBucketReplication,
+		CustomizeDiff: customizeDiffBucketReplication,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceMinioBucketReplicationV0Type(),
+				Upgrade: resourceMinioBucketReplicationStateUpgradeV0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:        schema.TypeString,
@@ -186,11 +195,12 @@ func bucketReplicationTargetResource() *schema.Resource {
 				Computed:    true,
 			},
 			"syncronous": {
-				Type:        schema.TypeBool,
-				Description: "Use synchronous replication.",
-				Optional:    true,
-				Computed:    true,
-				Deprecated:  "Use 'synchronous' instead. This attribute will be removed in a future major version.",
+				Type:             schema.TypeBool,
+				Description:      "Use synchronous replication.",
+				Optional:         true,
+				Computed:         true,
+				Deprecated:       "Use 'synchronous' instead. This attribute will be removed in a future major version.",
+				DiffSuppressFunc: suppressReplicationLegacySyncDiff,
 			},
 			"disable_proxy": {
 				Type:        schema.TypeBool,
@@ -286,6 +296,39 @@ func validateReplicationBandwidthLimit(i interface{}, _ cty.Path) (diags diag.Di
 	return
 }
 
+// customizeDiffBucketReplication probes the target endpoints when
+// validate_against_server is set. The probe is sent from where Terraform runs,
+// which may not reach the targets the way the MinIO server does.
+func customizeDiffBucketReplication(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !validateAgainstServer(meta) || !d.HasChange("rule") {
+		return nil
+	}
+
+	var endpoints []string
+	rules, _ := d.Get("rule").([]interface{})
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		targets, _ := rule["target"].([]interface{})
+		for _, t := range targets {
+			target, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			host, _ := target["host"].(string)
+			if host == "" {
+				continue
+			}
+			secure, _ := target["secure"].(bool)
+			endpoints = append(endpoints, endpointBaseURL(host, secure))
+		}
+	}
+
+	return checkEndpointsReachable(ctx, meta, endpoints)
+}
+
 func minioPutBucketReplication(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	bucketReplicationConfig, diags := BucketReplicationConfig(ctx, d, meta)
 	replicationConfig := bucketReplicationConfig.ReplicationRules
@@ -385,6 +428,21 @@ func minioReadBucketReplication(ctx context.Context, d *schema.ResourceData, met
 		return targetDiags
 	}
 
+	// Write the deprecated "syncronous" alias only when the configuration sets
+	// it, so that it can be dropped from the schema without breaking existing
+	// states. Refreshes have no configuration and leave it out of the state;
+	// suppressReplicationLegacySyncDiff hides the resulting diff.
+	for i, rule := range rules {
+		if !replicationTargetLegacySyncSet(d, i) {
+			continue
+		}
+		if targets, ok := rule["target"].([]interface{}); ok && len(targets) == 1 {
+			if target, ok := targets[0].(map[string]interface{}); ok {
+				target["syncronous"] = target["synchronous"]
+			}
+		}
+	}
+
 	if err := d.Set("bucket", d.Id()); err != nil {
 		return NewResourceError("setting replication configuration", d.Id(), err)
 	}
@@ -504,12 +562,9 @@ func applyRemoteTargetsToRules(ctx context.Context, bucketName string, bucketRep
 		target["secure"] = remoteTarget.Secure
 		target["path_style"] = remoteTarget.Path
 		target["path"] = strings.Join(pathComponent[:len(pathComponent)-1], "/")
-		// Runtime auto-migration:
-		// - Always write canonical "synchronous".
-		// - Also write deprecated "syncronous" so older configs/state continue to work.
-		// Both attributes are Optional+Computed, so this does not create perpetual diffs.
+		// The deprecated "syncronous" is only written by minioReadBucketReplication,
+		// when the configuration still uses it.
 		target["synchronous"] = remoteTarget.ReplicationSync
-		target["syncronous"] = remoteTarget.ReplicationSync
 		target["disable_proxy"] = remoteTarget.DisableProxy
 		target["health_check_period"] = shortDur(remoteTarget.HealthCheckDuration)
 		var bwUint64 uint64
@@ -951,28 +1006,12 @@ func resolveReplicationTargetSync(d *schema.ResourceData, i int, target map[stri
 	// Note: GetRawConfig returns null during Read operations (when reading from state),
 	// so we fall back to the decoded value from the target map in that case.
 	rawConfig := d.GetRawConfig()
-	if !rawConfig.IsNull() {
-		rulesAttr := rawConfig.GetAttr("rule")
-		if !rulesAttr.IsNull() && rulesAttr.IsKnown() && rulesAttr.LengthInt() > i {
-			ruleVal := rulesAttr.Index(cty.NumberIntVal(int64(i)))
-			if !ruleVal.IsNull() && ruleVal.IsKnown() {
-				targetAttr := ruleVal.GetAttr("target")
-				if !targetAttr.IsNull() && targetAttr.IsKnown() && targetAttr.LengthInt() > 0 {
-					targetVal := targetAttr.Index(cty.NumberIntVal(0))
-					if !targetVal.IsNull() && targetVal.IsKnown() {
-						syncAttr := targetVal.GetAttr("synchronous")
-						if !syncAttr.IsNull() && syncAttr.IsKnown() {
-							return syncAttr.True()
-						}
-						// Fall back to deprecated "syncronous" if "synchronous" not set
-						syncronousAttr := targetVal.GetAttr("syncronous")
-						if !syncronousAttr.IsNull() && syncronousAttr.IsKnown() {
-							return syncronousAttr.True()
-						}
-					}
-				}
-			}
-		}
+	if syncAttr := replicationTargetRawAttr(rawConfig, i, "synchronous"); !syncAttr.IsNull() {
+		return syncAttr.True()
+	}
+	// Fall back to deprecated "syncronous" if "synchronous" not set
+	if syncronousAttr := replicationTargetRawAttr(rawConfig, i, "syncronous"); !syncronousAttr.IsNull() {
+		return syncronousAttr.True()
 	}
 	// Fall back to decoded value from state when raw config is not available (e.g., during Read)
 	if v, ok := target["synchronous"].(bool); ok {
@@ -984,6 +1023,52 @@ func resolveReplicationTargetSync(d *schema.ResourceData, i int, target map[stri
 	return false
 }
 
+// replicationTargetLegacySyncSet reports whether the deprecated "syncronous"
+// attribute of the target of rule i is set in the configuration.
+func replicationTargetLegacySyncSet(d *schema.ResourceData, i int) bool {
+	return !replicationTargetRawAttr(d.GetRawConfig(), i, "syncronous").IsNull()
+}
+
+// suppressReplicationLegacySyncDiff hides the diff of a configured
+// "syncronous" that is missing from the state, as it is after a refresh or
+// the upgrade of a version 0 state, as long as it matches "synchronous".
+func suppressReplicationLegacySyncDiff(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if oldValue != "" {
+		return false
+	}
+	synchronous, ok := d.GetOk(strings.TrimSuffix(k, "syncronous") + "synchronous")
+	return strconv.FormatBool(ok && synchronous.(bool)) == newValue
+}
+
+// replicationTargetRawAttr returns the attribute of the target of rule i in a
+// raw configuration or state value, or a null value when it is not set or not
+// known yet.
+func replicationTargetRawAttr(raw cty.Value, i int, attr string) cty.Value {
+	value := raw
+	for _, step := range []struct {
+		attr  string
+		index int
+	}{{"rule", i}, {"target", 0}} {
+		if value.IsNull() || !value.IsKnown() {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+		list := value.GetAttr(step.attr)
+		if list.IsNull() || !list.IsKnown() || list.LengthInt() <= step.index {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+		value = list.Index(cty.NumberIntVal(int64(step.index)))
+	}
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	value = value.GetAttr(attr)
+	if !value.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return value
+}
+
 func formatBucketTargetForLog(t *madmin.BucketTarget) string {
 	return fmt.Sprintf("targetBucket=%q, endpoint=%q, region=%q, secure=%t, path=%q, bandwidthLimit=%d, sync=%t, disableProxy=%t, healthCheckDuration=%s",
 		t.TargetBucket,
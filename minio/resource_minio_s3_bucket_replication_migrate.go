@@ -0,0 +1,102 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// replicationTargetAttributeAliases maps the misspelled target attributes
+// removed from the state in schema version 1 to their canonical names.
+var replicationTargetAttributeAliases = map[string]string{
+	"syncronous":     "synchronous",
+	"bandwidth_limt": "bandwidth_limit",
+}
+
+// resourceMinioBucketReplicationV0Type is the type of the state of
+// minio_s3_bucket_replication at schema version 0. It is spelled out rather
+// than derived from the resource schema, which will lose the deprecated
+// attributes.
+func resourceMinioBucketReplicationV0Type() cty.Type {
+	target := cty.Object(map[string]cty.Type{
+		"bucket":              cty.String,
+		"storage_class":       cty.String,
+		"host":                cty.String,
+		"secure":              cty.Bool,
+		"path_style":          cty.String,
+		"path":                cty.String,
+		"synchronous":         cty.Bool,
+		"syncronous":          cty.Bool,
+		"disable_proxy":       cty.Bool,
+		"health_check_period": cty.String,
+		"bandwidth_limit":     cty.String,
+		"bandwidth_limt":      cty.String,
+		"region":              cty.String,
+		"access_key":          cty.String,
+		"secret_key":          cty.String,
+	})
+	rule := cty.Object(map[string]cty.Type{
+		"id":                          cty.String,
+		"arn":                         cty.String,
+		"enabled":                     cty.Bool,
+		"priority":                    cty.Number,
+		"prefix":                      cty.String,
+		"tags":                        cty.Map(cty.String),
+		"delete_replication":          cty.Bool,
+		"delete_marker_replication":   cty.Bool,
+		"existing_object_replication": cty.Bool,
+		"metadata_sync":               cty.Bool,
+		"target":                      cty.List(target),
+	})
+	return cty.Object(map[string]cty.Type{
+		"id":             cty.String,
+		"bucket":         cty.String,
+		"resync_version": cty.Number,
+		"last_resync_id": cty.String,
+		"rule":           cty.List(rule),
+	})
+}
+
+// resourceMinioBucketReplicationStateUpgradeV0 moves the values of the
+// misspelled target attributes to their canonical names. Version 0 states
+// always hold "syncronous" next to "synchronous", and "bandwidth_limt" when it
+// was configured; the canonical attribute wins when both are set.
+func resourceMinioBucketReplicationStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	rules, _ := rawState["rule"].([]interface{})
+	for i, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		targets, _ := rule["target"].([]interface{})
+		for _, t := range targets {
+			target, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for alias, name := range replicationTargetAttributeAliases {
+				value, ok := target[alias]
+				if !ok {
+					continue
+				}
+				if isEmptyStateValue(target[name]) && !isEmptyStateValue(value) {
+					tflog.Debug(ctx, fmt.Sprintf("Upgrading rule#%d: moving target %s to %s", i, alias, name))
+					target[name] = value
+				}
+				delete(target, alias)
+			}
+		}
+	}
+
+	return rawState, nil
+}
+
+func isEmptyStateValue(value interface{}) bool {
+	return value == nil || value == ""
+}
@@ -0,0 +1,138 @@
+package minio
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceMinioBucketReplicationV0Type(t *testing.T) {
+	// Until the deprecated attributes are removed, the version 0 type is the
+	// type of the current schema.
+	want := resourceMinioBucketReplication().CoreConfigSchema().ImpliedType()
+	if got := resourceMinioBucketReplicationV0Type(); !got.Equals(want) {
+		t.Errorf("version 0 type is\n%#v\nwant\n%#v", got, want)
+	}
+}
+
+func TestResourceMinioBucketReplicationStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":     "source",
+		"bucket": "source",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"priority": 1,
+				"target": []interface{}{
+					map[string]interface{}{
+						"bucket":          "target-a",
+						"synchronous":     true,
+						"syncronous":      true,
+						"bandwidth_limit": "100 MB",
+						"bandwidth_limt":  "100M",
+					},
+				},
+			},
+			map[string]interface{}{
+				"priority": 2,
+				"target": []interface{}{
+					// Written by a provider version predating the canonical names.
+					map[string]interface{}{
+						"bucket":          "target-b",
+						"syncronous":      true,
+						"bandwidth_limit": "",
+						"bandwidth_limt":  "200M",
+					},
+				},
+			},
+			map[string]interface{}{
+				"priority": 3,
+				"target": []interface{}{
+					map[string]interface{}{
+						"bucket":          "target-c",
+						"synchronous":     false,
+						"syncronous":      nil,
+						"bandwidth_limit": "0 B",
+					},
+				},
+			},
+		},
+	}
+
+	got, err := resourceMinioBucketReplicationStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("upgrading state: %v", err)
+	}
+
+	wantTargets := []map[string]interface{}{
+		{"bucket": "target-a", "synchronous": true, "bandwidth_limit": "100 MB"},
+		{"bucket": "target-b", "synchronous": true, "bandwidth_limit": "200M"},
+		{"bucket": "target-c", "synchronous": false, "bandwidth_limit": "0 B"},
+	}
+	for i, r := range got["rule"].([]interface{}) {
+		target := r.(map[string]interface{})["target"].([]interface{})[0]
+		if !reflect.DeepEqual(target, wantTargets[i]) {
+			t.Errorf("rule#%d target upgraded to %v, want %v", i, target, wantTargets[i])
+		}
+	}
+
+	if got, err := resourceMinioBucketReplicationStateUpgradeV0(context.Background(), nil, nil); got != nil || err != nil {
+		t.Errorf("upgrading a nil state returned %v, %v", got, err)
+	}
+}
+
+func TestReplicationTargetRawAttr(t *testing.T) {
+	target := func(sync cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"rule": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"target": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"syncronous": sync,
+				})}),
+			})}),
+		})
+	}
+
+	if v := replicationTargetRawAttr(target(cty.True), 0, "syncronous"); v.IsNull() || !v.True() {
+		t.Errorf("rule#0 syncronous = %#v, want true", v)
+	}
+	if v := replicationTargetRawAttr(target(cty.NullVal(cty.Bool)), 0, "syncronous"); !v.IsNull() {
+		t.Errorf("unset syncronous = %#v, want null", v)
+	}
+	if v := replicationTargetRawAttr(target(cty.UnknownVal(cty.Bool)), 0, "syncronous"); !v.IsNull() {
+		t.Errorf("unknown syncronous = %#v, want null", v)
+	}
+	if v := replicationTargetRawAttr(target(cty.True), 1, "syncronous"); !v.IsNull() {
+		t.Errorf("missing rule#1 syncronous = %#v, want null", v)
+	}
+	if v := replicationTargetRawAttr(cty.NullVal(target(cty.True).Type()), 0, "syncronous"); !v.IsNull() {
+		t.Errorf("syncronous of a null state = %#v, want null", v)
+	}
+}
+
+func TestSuppressReplicationLegacySyncDiff(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceMinioBucketReplication().Schema, map[string]interface{}{
+		"bucket": "source",
+		"rule": []interface{}{map[string]interface{}{
+			"target": []interface{}{map[string]interface{}{
+				"bucket":      "target",
+				"host":        "minio.example.com:9000",
+				"access_key":  "access",
+				"secret_key":  "secret",
+				"synchronous": true,
+			}},
+		}},
+	})
+	key := "rule.0.target.0.syncronous"
+
+	if !suppressReplicationLegacySyncDiff(key, "", "true", d) {
+		t.Error("a configured syncronous matching synchronous should not show a diff")
+	}
+	if suppressReplicationLegacySyncDiff(key, "", "false", d) {
+		t.Error("a configured syncronous differing from synchronous should show a diff")
+	}
+	if suppressReplicationLegacySyncDiff(key, "true", "false", d) {
+		t.Error("a change of a syncronous held in state should show a diff")
+	}
+}
@@ -18,7 +18,8 @@ func resourceMinioBucketTags() *schema.Resource {
 		UpdateContext: minioUpdateBucketTags,
 		DeleteContext: minioDeleteBucketTags,
 		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
-		Description:   "Manages tags for S3 buckets in MinIO.",
+		CustomizeDiff: customizeDiffTagsAll,
+		Description:   "Manages tags for S3 buckets in MinIO. Tags of the provider `default_tags` block are merged into the configured tags.",
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:        schema.TypeString,
@@ -33,6 +34,7 @@ func resourceMinioBucketTags() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Map of tags to assign to the bucket",
 			},
+			"tags_all": tagsAllSchema("bucket"),
 		},
 	}
 }
@@ -44,14 +46,13 @@ func minioCreateBucketTags(ctx context.Context, d *schema.ResourceData, meta int
 
 	if shouldSkipBucketTagging(cfg) {
 		tflog.Info(ctx, "Bucket tagging is disabled for this provider configuration; skipping tag creation")
-		preserveBucketTagsState(d)
+		preserveBucketTagsState(d, meta)
 		d.SetId(bucket)
 		return nil
 	}
 
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
-		tagsMap := v.(map[string]interface{})
-		bucketTags, err := tags.NewTags(convertToStringMap(tagsMap), false)
+	if tagsAll := resourceTagsAll(d, meta); len(tagsAll) > 0 {
+		bucketTags, err := tags.NewTags(tagsAll, false)
 		if err != nil {
 			return NewResourceError("creating bucket tags", bucket, err)
 		}
@@ -60,7 +61,7 @@ func minioCreateBucketTags(ctx context.Context, d *schema.ResourceData, meta int
 				return NewResourceError("setting bucket tags", bucket, err)
 			}
 			tflog.Info(ctx, "Bucket tagging is not supported by backend; preserving state")
-			preserveBucketTagsState(d)
+			preserveBucketTagsState(d, meta)
 		}
 	}
 	d.SetId(bucket)
@@ -73,7 +74,7 @@ func minioReadBucketTags(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if shouldSkipBucketTagging(cfg) {
 		tflog.Info(ctx, "Bucket tagging is disabled for this provider configuration; preserving state")
-		preserveBucketTagsState(d)
+		preserveBucketTagsState(d, meta)
 		_ = d.Set("bucket", bucket)
 		return nil
 	}
@@ -83,7 +84,7 @@ func minioReadBucketTags(ctx context.Context, d *schema.ResourceData, meta inter
 		var minioErr minio.ErrorResponse
 		if errors.As(err, &minioErr) && minioErr.Code == "NoSuchTagSet" {
 			_ = d.Set("bucket", bucket)
-			_ = d.Set("tags", map[string]string{})
+			_ = setResourceTags(d, meta, map[string]string{})
 			return nil
 		}
 		if isNoSuchBucketError(err) {
@@ -93,7 +94,7 @@ func minioReadBucketTags(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 		if IsS3TaggingNotImplemented(err) {
 			tflog.Info(ctx, "Bucket tagging is not supported by backend; preserving state")
-			preserveBucketTagsState(d)
+			preserveBucketTagsState(d, meta)
 			_ = d.Set("bucket", bucket)
 			return nil
 		}
@@ -102,7 +103,9 @@ func minioReadBucketTags(ctx context.Context, d *schema.ResourceData, meta inter
 	if err := d.Set("bucket", bucket); err != nil {
 		return NewResourceError("setting bucket", bucket, err)
 	}
-	_ = d.Set("tags", bucketTags.ToMap())
+	if err := setResourceTags(d, meta, bucketTags.ToMap()); err != nil {
+		return NewResourceError("setting tags", bucket, err)
+	}
 	return nil
 }
 
@@ -112,14 +115,13 @@ func minioUpdateBucketTags(ctx context.Context, d *schema.ResourceData, meta int
 
 	if shouldSkipBucketTagging(cfg) {
 		tflog.Info(ctx, "Bucket tagging is disabled for this provider configuration; preserving state")
-		preserveBucketTagsState(d)
+		preserveBucketTagsState(d, meta)
 		return nil
 	}
 
-	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
-			tagsMap := v.(map[string]interface{})
-			bucketTags, err := tags.NewTags(convertToStringMap(tagsMap), false)
+	if d.HasChanges("tags", "tags_all") {
+		if tagsAll := resourceTagsAll(d, meta); len(tagsAll) > 0 {
+			bucketTags, err := tags.NewTags(tagsAll, false)
 			if err != nil {
 				return NewResourceError("updating bucket tags", bucket, err)
 			}
@@ -128,7 +130,7 @@ func minioUpdateBucketTags(ctx context.Context, d *schema.ResourceData, meta int
 					return NewResourceError("setting bucket tags", bucket, err)
 				}
 				tflog.Info(ctx, "Bucket tagging is not supported by backend; preserving state")
-				preserveBucketTagsState(d)
+				preserveBucketTagsState(d, meta)
 			}
 		} else {
 			if err := cfg.MinioClient.RemoveBucketTagging(ctx, bucket); err != nil {
@@ -136,7 +138,7 @@ func minioUpdateBucketTags(ctx context.Context, d *schema.ResourceData, meta int
 					return NewResourceError("removing bucket tags", bucket, err)
 				}
 				tflog.Info(ctx, "Bucket tagging is not supported by backend; preserving state")
-				preserveBucketTagsState(d)
+				preserveBucketTagsState(d, meta)
 			}
 		}
 	}
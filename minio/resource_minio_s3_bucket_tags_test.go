@@ -128,6 +128,58 @@ func TestAccMinioS3BucketTags_emptyTagsNoDrift(t *testing.T) {
 	})
 }
 
+func TestAccMinioS3BucketTags_defaultTags(t *testing.T) {
+	bucketName := "tfacc-tags-defaults-" + acctest.RandString(8)
+	resourceName := "minio_s3_bucket_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckMinioBucketTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioS3BucketTagsConfigDefaultTags(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMinioS3BucketTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "data"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "data"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.cost-center", "42"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMinioS3BucketTagsConfigDefaultTags(bucketName string) string {
+	return fmt.Sprintf(`
+provider "minio" {
+  default_tags {
+    tags = {
+      owner       = "platform"
+      cost-center = "42"
+    }
+  }
+}
+
+resource "minio_s3_bucket" "bucket" {
+  bucket = "%s"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "minio_s3_bucket_tags" "test" {
+  bucket = minio_s3_bucket.bucket.id
+  tags = {
+    owner = "data"
+  }
+}
+`, bucketName)
+}
+
 func testAccMinioS3BucketTagsConfigEmpty(bucketName string) string {
 	return fmt.Sprintf(`
 resource "minio_s3_bucket" "bucket" {
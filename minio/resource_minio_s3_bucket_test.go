@@ -1695,15 +1695,68 @@ resource "minio_s3_bucket" "bucket" {
 `, randInt)
 }
 
+func TestAccMinioS3Bucket_defaultTags(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	resourceName := "minio_s3_bucket.bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckMinioS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMinioS3BucketConfigDefaultTags(bucketName, "platform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMinioS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "test-bucket"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.cost-center", "42"),
+				),
+			},
+			{
+				Config: testAccMinioS3BucketConfigDefaultTags(bucketName, "data"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMinioS3BucketConfigDefaultTags(bucketName, owner string) string {
+	return fmt.Sprintf(`
+provider "minio" {
+  default_tags {
+    tags = {
+      owner       = %q
+      cost-center = "42"
+    }
+  }
+}
+
+resource "minio_s3_bucket" "bucket" {
+  bucket = %q
+
+  tags = {
+    Name = "test-bucket"
+  }
+}
+`, owner, bucketName)
+}
+
 // TestMinioReadBucket_taggingNotImplemented verifies that Read still writes
 // tags into state when the backend does not implement bucket tagging (e.g.
 // Hetzner Object Storage / Ceph RGW). If tags never exists in state, the
 // Optional+Computed attribute is planned as unknown on every run and never converges.
 func TestMinioReadBucket_taggingNotImplemented(t *testing.T) {
 	cases := []struct {
-		name      string
-		rawConfig map[string]interface{}
-		wantAttrs map[string]string
+		name        string
+		rawConfig   map[string]interface{}
+		defaultTags map[string]string
+		wantAttrs   map[string]string
 	}{
 		{
 			name:      "no tags writes empty map into state",
@@ -1718,6 +1771,15 @@ func TestMinioReadBucket_taggingNotImplemented(t *testing.T) {
 			},
 			wantAttrs: map[string]string{"tags.%": "1", "tags.env": "prod"},
 		},
+		{
+			name: "default tags are merged into tags_all",
+			rawConfig: map[string]interface{}{
+				"bucket": "test-bucket",
+				"tags":   map[string]interface{}{"env": "prod"},
+			},
+			defaultTags: map[string]string{"owner": "platform"},
+			wantAttrs:   map[string]string{"tags.%": "1", "tags_all.%": "2", "tags_all.owner": "platform"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -1742,10 +1804,13 @@ func TestMinioReadBucket_taggingNotImplemented(t *testing.T) {
 				t.Fatalf("creating S3 client: %v", err)
 			}
 
-			d := schema.TestResourceDataRaw(t, resourceMinioBucket().Schema, tc.rawConfig)
-			d.SetId("test-bucket")
+			// Go through the resource so the data carries its identity schema.
+			r := resourceMinioBucket()
+			raw := schema.TestResourceDataRaw(t, r.Schema, tc.rawConfig)
+			raw.SetId("test-bucket")
+			d := r.Data(raw.State())
 
-			meta := &S3MinioClient{S3Client: s3Client}
+			meta := &S3MinioClient{S3Client: s3Client, DefaultTags: tc.defaultTags}
 			if diags := minioReadBucket(context.Background(), d, meta); len(diags) > 0 {
 				t.Fatalf("read returned diagnostics: %v", diags)
 			}
@@ -14,10 +14,11 @@ import (
 
 func resourceMinioS3IncompleteUploadCleanup() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: minioCreateIncompleteUploadCleanup,
-		ReadContext:   minioReadIncompleteUploadCleanup,
-		UpdateContext: minioUpdateIncompleteUploadCleanup,
-		DeleteContext: minioDeleteIncompleteUploadCleanup,
+		CreateContext:      minioCreateIncompleteUploadCleanup,
+		DeprecationMessage: "Use the minio_incomplete_upload_cleanup action instead (Terraform 1.14+). This resource will be removed in a future major version.",
+		ReadContext:        minioReadIncompleteUploadCleanup,
+		UpdateContext:      minioUpdateIncompleteUploadCleanup,
+		DeleteContext:      minioDeleteIncompleteUploadCleanup,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -60,7 +61,7 @@ func minioCreateIncompleteUploadCleanup(ctx context.Context, d *schema.ResourceD
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating incomplete upload cleanup for bucket: %s", config.MinioBucket))
 
-	if err := cleanupIncompleteUploads(ctx, config); err != nil {
+	if _, err := cleanupIncompleteUploads(ctx, config); err != nil {
 		return NewResourceError("cleaning up incomplete uploads", config.MinioBucket, err)
 	}
 
@@ -108,7 +109,7 @@ func minioUpdateIncompleteUploadCleanup(ctx context.Context, d *schema.ResourceD
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating incomplete upload cleanup for: %s", config.MinioBucket))
 
-	if err := cleanupIncompleteUploads(ctx, config); err != nil {
+	if _, err := cleanupIncompleteUploads(ctx, config); err != nil {
 		return NewResourceError("cleaning up incomplete uploads", config.MinioBucket, err)
 	}
 
@@ -126,7 +127,9 @@ func minioDeleteIncompleteUploadCleanup(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func cleanupIncompleteUploads(ctx context.Context, config *S3MinioIncompleteUploadCleanup) error {
+// cleanupIncompleteUploads aborts the incomplete multipart uploads under the
+// configured prefix and returns how many were aborted.
+func cleanupIncompleteUploads(ctx context.Context, config *S3MinioIncompleteUploadCleanup) (int, error) {
 	tflog.Debug(ctx, fmt.Sprintf("Listing incomplete uploads for bucket: %s, prefix: %s", config.MinioBucket, config.MinioPrefix))
 
 	incompleteCh := config.MinioClient.ListIncompleteUploads(ctx, config.MinioBucket, config.MinioPrefix, true)
@@ -150,9 +153,9 @@ func cleanupIncompleteUploads(ctx context.Context, config *S3MinioIncompleteUplo
 	}
 
 	if len(cleanupErrors) > 0 {
-		return fmt.Errorf("errors during cleanup: %s", strings.Join(cleanupErrors, "; "))
+		return cleanedCount, fmt.Errorf("errors during cleanup: %s", strings.Join(cleanupErrors, "; "))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Cleaned up %d incomplete uploads in bucket %s", cleanedCount, config.MinioBucket))
-	return nil
+	return cleanedCount, nil
 }
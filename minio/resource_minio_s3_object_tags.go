@@ -19,7 +19,8 @@ func resourceMinioObjectTags() *schema.Resource {
 		UpdateContext: minioUpdateObjectTags,
 		DeleteContext: minioDeleteObjectTags,
 		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
-		Description:   "Manages tags for S3 objects in a MinIO bucket.",
+		CustomizeDiff: customizeDiffTagsAll,
+		Description:   "Manages tags for S3 objects in a MinIO bucket. Tags of the provider `default_tags` block are merged into the configured tags.",
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:        schema.TypeString,
@@ -39,6 +40,7 @@ func resourceMinioObjectTags() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Map of tags to assign to the object",
 			},
+			"tags_all": tagsAllSchema("object"),
 		},
 	}
 }
@@ -66,9 +68,7 @@ func minioCreateObjectTags(ctx context.Context, d *schema.ResourceData, meta int
 
 	tflog.Debug(ctx, fmt.Sprintf("Setting tags for object %s in bucket %s", objectKey, bucket))
 
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
-		tagsMap := convertToStringMap(v.(map[string]interface{}))
-
+	if tagsMap := resourceTagsAll(d, meta); len(tagsMap) > 0 {
 		srcOpts := minio.CopySrcOptions{
 			Bucket: bucket,
 			Object: objectKey,
@@ -113,7 +113,7 @@ func minioReadObjectTags(ctx context.Context, d *schema.ResourceData, meta inter
 			if err := d.Set("key", objectKey); err != nil {
 				return NewResourceError("setting key", fmt.Sprintf("%s/%s", bucket, objectKey), err)
 			}
-			_ = d.Set("tags", map[string]string{})
+			_ = setResourceTags(d, meta, map[string]string{})
 			return nil
 		}
 		return NewResourceError("reading object tags", fmt.Sprintf("%s/%s", bucket, objectKey), err)
@@ -125,7 +125,7 @@ func minioReadObjectTags(ctx context.Context, d *schema.ResourceData, meta inter
 	if err := d.Set("key", objectKey); err != nil {
 		return NewResourceError("setting key", fmt.Sprintf("%s/%s", bucket, objectKey), err)
 	}
-	if err := d.Set("tags", objectTags.ToMap()); err != nil {
+	if err := setResourceTags(d, meta, objectTags.ToMap()); err != nil {
 		return NewResourceError("setting tags", fmt.Sprintf("%s/%s", bucket, objectKey), err)
 	}
 	return nil
@@ -143,10 +143,8 @@ func minioUpdateObjectTags(ctx context.Context, d *schema.ResourceData, meta int
 		MinioClient: meta.(*S3MinioClient).S3Client,
 	}
 
-	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
-			tagsMap := convertToStringMap(v.(map[string]interface{}))
-
+	if d.HasChanges("tags", "tags_all") {
+		if tagsMap := resourceTagsAll(d, meta); len(tagsMap) > 0 {
 			srcOpts := minio.CopySrcOptions{
 				Bucket: bucket,
 				Object: objectKey,
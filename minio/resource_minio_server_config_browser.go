@@ -0,0 +1,123 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var serverConfigBrowserFields = serverConfigFields{
+	values: []string{"csp_policy", "hsts_seconds", "referrer_policy"},
+	bools:  []string{"hsts_include_subdomains", "hsts_preload"},
+}
+
+func resourceMinioServerConfigBrowser() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the HTTP security headers MinIO sends with Console responses: Content-Security-Policy, Strict-Transport-Security and Referrer-Policy.",
+		CreateContext: minioServerConfigBrowserSet,
+		ReadContext:   minioServerConfigBrowserRead,
+		UpdateContext: minioServerConfigBrowserSet,
+		DeleteContext: minioServerConfigBrowserDelete,
+		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Schema: map[string]*schema.Schema{
+			"csp_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Value of the Content-Security-Policy header (e.g., \"default-src 'self'\").",
+			},
+			"hsts_seconds": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a non-negative number of seconds"),
+				Description:  "max-age of the Strict-Transport-Security header in seconds. \"0\" disables the header.",
+			},
+			"hsts_include_subdomains": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Add includeSubDomains to the Strict-Transport-Security header.",
+			},
+			"hsts_preload": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Add preload to the Strict-Transport-Security header.",
+			},
+			"referrer_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"no-referrer", "no-referrer-when-downgrade", "origin", "origin-when-cross-origin",
+					"same-origin", "strict-origin", "strict-origin-when-cross-origin", "unsafe-url",
+				}, false),
+				Description: "Value of the Referrer-Policy header (e.g., \"strict-origin-when-cross-origin\").",
+			},
+			"restart_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a MinIO server restart is required.",
+			},
+		},
+	}
+}
+
+func minioServerConfigBrowserSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	parts := serverConfigParams(d, serverConfigBrowserFields)
+	if len(parts) == 0 {
+		d.SetId("browser")
+		return minioServerConfigBrowserRead(ctx, d, meta)
+	}
+
+	configString := "browser " + strings.Join(parts, " ")
+	restart, err := admin.SetConfigKV(ctx, configString)
+	if err != nil {
+		return NewResourceError("setting browser configuration", "browser", err)
+	}
+
+	d.SetId("browser")
+	_ = d.Set("restart_required", restart)
+	tflog.Debug(ctx, fmt.Sprintf("Set browser config (restart_required=%v)", restart))
+
+	return minioServerConfigBrowserRead(ctx, d, meta)
+}
+
+func minioServerConfigBrowserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	cfgMap, err := getServerConfigSubsys(ctx, admin, "browser")
+	if err != nil {
+		return NewResourceError("reading browser configuration", "browser", err)
+	}
+
+	d.SetId("browser")
+	if err := setServerConfigFields(d, cfgMap, serverConfigBrowserFields); err != nil {
+		return NewResourceError("setting browser configuration", "browser", err)
+	}
+
+	return nil
+}
+
+func minioServerConfigBrowserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	_, err := admin.DelConfigKV(ctx, "browser")
+	if err != nil {
+		errMsg := strings.ToLower(err.Error())
+		if !strings.Contains(errMsg, "not found") {
+			return NewResourceError("resetting browser configuration", "browser", err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
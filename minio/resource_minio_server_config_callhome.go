@@ -0,0 +1,98 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var serverConfigCallhomeFields = serverConfigFields{
+	values: []string{"frequency"},
+	bools:  []string{"enable"},
+}
+
+func resourceMinioServerConfigCallhome() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages MinIO callhome, which periodically uploads cluster diagnostics to SUBNET. Requires the cluster to be registered with SUBNET.",
+		CreateContext: minioServerConfigCallhomeSet,
+		ReadContext:   minioServerConfigCallhomeRead,
+		UpdateContext: minioServerConfigCallhomeSet,
+		DeleteContext: minioServerConfigCallhomeDelete,
+		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Schema: map[string]*schema.Schema{
+			"enable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable periodic upload of diagnostics to SUBNET.",
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateServerConfigDuration,
+				Description:  "Interval between diagnostics uploads (e.g., \"24h\").",
+			},
+			"restart_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a MinIO server restart is required.",
+			},
+		},
+	}
+}
+
+func minioServerConfigCallhomeSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	parts := serverConfigParams(d, serverConfigCallhomeFields)
+	if len(parts) == 0 {
+		d.SetId("callhome")
+		return minioServerConfigCallhomeRead(ctx, d, meta)
+	}
+
+	configString := "callhome " + strings.Join(parts, " ")
+	restart, err := admin.SetConfigKV(ctx, configString)
+	if err != nil {
+		return NewResourceError("setting callhome configuration", "callhome", err)
+	}
+
+	d.SetId("callhome")
+	_ = d.Set("restart_required", restart)
+	tflog.Debug(ctx, fmt.Sprintf("Set callhome config (restart_required=%v)", restart))
+
+	return minioServerConfigCallhomeRead(ctx, d, meta)
+}
+
+func minioServerConfigCallhomeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	cfgMap, err := getServerConfigSubsys(ctx, admin, "callhome")
+	if err != nil {
+		return NewResourceError("reading callhome configuration", "callhome", err)
+	}
+
+	d.SetId("callhome")
+	if err := setServerConfigFields(d, cfgMap, serverConfigCallhomeFields); err != nil {
+		return NewResourceError("setting callhome configuration", "callhome", err)
+	}
+
+	return nil
+}
+
+func minioServerConfigCallhomeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	_, err := admin.DelConfigKV(ctx, "callhome")
+	if err != nil {
+		errMsg := strings.ToLower(err.Error())
+		if !strings.Contains(errMsg, "not found") {
+			return NewResourceError("resetting callhome configuration", "callhome", err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,118 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var serverConfigCompressionFields = serverConfigFields{
+	bools: []string{"enable", "allow_encryption"},
+	lists: []string{"extensions", "mime_types"},
+}
+
+func resourceMinioServerConfigCompression() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages MinIO transparent object compression. Controls which objects are compressed on write, by file extension or content type.",
+		CreateContext: minioServerConfigCompressionSet,
+		ReadContext:   minioServerConfigCompressionRead,
+		UpdateContext: minioServerConfigCompressionSet,
+		DeleteContext: minioServerConfigCompressionDelete,
+		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Schema: map[string]*schema.Schema{
+			"enable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable compression of new objects.",
+			},
+			"allow_encryption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Also compress objects that are encrypted. Compressing encrypted objects may leak information about their content.",
+			},
+			"extensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(serverConfigExtensionRegexp, "must be a file extension starting with a dot, e.g. \".txt\""),
+				},
+				Description: "File extensions to compress (e.g., [\".txt\", \".log\"]).",
+			},
+			"mime_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(serverConfigMimeTypeRegexp, "must be a MIME type, optionally with a wildcard subtype, e.g. \"text/*\""),
+				},
+				Description: "Content types to compress (e.g., [\"text/*\", \"application/json\"]).",
+			},
+			"restart_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a MinIO server restart is required.",
+			},
+		},
+	}
+}
+
+func minioServerConfigCompressionSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	parts := serverConfigParams(d, serverConfigCompressionFields)
+	if len(parts) == 0 {
+		d.SetId("compression")
+		return minioServerConfigCompressionRead(ctx, d, meta)
+	}
+
+	configString := "compression " + strings.Join(parts, " ")
+	restart, err := admin.SetConfigKV(ctx, configString)
+	if err != nil {
+		return NewResourceError("setting compression configuration", "compression", err)
+	}
+
+	d.SetId("compression")
+	_ = d.Set("restart_required", restart)
+	tflog.Debug(ctx, fmt.Sprintf("Set compression config (restart_required=%v)", restart))
+
+	return minioServerConfigCompressionRead(ctx, d, meta)
+}
+
+func minioServerConfigCompressionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	cfgMap, err := getServerConfigSubsys(ctx, admin, "compression")
+	if err != nil {
+		return NewResourceError("reading compression configuration", "compression", err)
+	}
+
+	d.SetId("compression")
+	if err := setServerConfigFields(d, cfgMap, serverConfigCompressionFields); err != nil {
+		return NewResourceError("setting compression configuration", "compression", err)
+	}
+
+	return nil
+}
+
+func minioServerConfigCompressionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	_, err := admin.DelConfigKV(ctx, "compression")
+	if err != nil {
+		errMsg := strings.ToLower(err.Error())
+		if !strings.Contains(errMsg, "not found") {
+			return NewResourceError("resetting compression configuration", "compression", err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
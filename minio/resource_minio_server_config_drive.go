@@ -0,0 +1,91 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var serverConfigDriveFields = serverConfigFields{
+	values: []string{"max_timeout"},
+}
+
+func resourceMinioServerConfigDrive() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages MinIO drive settings. Controls how long drive operations may take before a drive is considered faulty.",
+		CreateContext: minioServerConfigDriveSet,
+		ReadContext:   minioServerConfigDriveRead,
+		UpdateContext: minioServerConfigDriveSet,
+		DeleteContext: minioServerConfigDriveDelete,
+		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Schema: map[string]*schema.Schema{
+			"max_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateServerConfigDuration,
+				Description:  "Maximum time a drive operation may take before it is timed out (e.g., \"30s\").",
+			},
+			"restart_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a MinIO server restart is required.",
+			},
+		},
+	}
+}
+
+func minioServerConfigDriveSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	parts := serverConfigParams(d, serverConfigDriveFields)
+	if len(parts) == 0 {
+		d.SetId("drive")
+		return minioServerConfigDriveRead(ctx, d, meta)
+	}
+
+	configString := "drive " + strings.Join(parts, " ")
+	restart, err := admin.SetConfigKV(ctx, configString)
+	if err != nil {
+		return NewResourceError("setting drive configuration", "drive", err)
+	}
+
+	d.SetId("drive")
+	_ = d.Set("restart_required", restart)
+	tflog.Debug(ctx, fmt.Sprintf("Set drive config (restart_required=%v)", restart))
+
+	return minioServerConfigDriveRead(ctx, d, meta)
+}
+
+func minioServerConfigDriveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	cfgMap, err := getServerConfigSubsys(ctx, admin, "drive")
+	if err != nil {
+		return NewResourceError("reading drive configuration", "drive", err)
+	}
+
+	d.SetId("drive")
+	if err := setServerConfigFields(d, cfgMap, serverConfigDriveFields); err != nil {
+		return NewResourceError("setting drive configuration", "drive", err)
+	}
+
+	return nil
+}
+
+func minioServerConfigDriveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	_, err := admin.DelConfigKV(ctx, "drive")
+	if err != nil {
+		errMsg := strings.ToLower(err.Error())
+		if !strings.Contains(errMsg, "not found") {
+			return NewResourceError("resetting drive configuration", "drive", err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,98 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var serverConfigIlmFields = serverConfigFields{
+	values: []string{"transition_workers", "expiration_workers"},
+}
+
+func resourceMinioServerConfigIlm() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages MinIO lifecycle (ILM) worker settings. Controls how many workers transition and expire objects in the background.",
+		CreateContext: minioServerConfigIlmSet,
+		ReadContext:   minioServerConfigIlmRead,
+		UpdateContext: minioServerConfigIlmSet,
+		DeleteContext: minioServerConfigIlmDelete,
+		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Schema: map[string]*schema.Schema{
+			"transition_workers": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateServerConfigPositiveInt,
+				Description:  "Number of workers transitioning objects to remote tiers.",
+			},
+			"expiration_workers": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateServerConfigPositiveInt,
+				Description:  "Number of workers expiring objects.",
+			},
+			"restart_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a MinIO server restart is required.",
+			},
+		},
+	}
+}
+
+func minioServerConfigIlmSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	parts := serverConfigParams(d, serverConfigIlmFields)
+	if len(parts) == 0 {
+		d.SetId("ilm")
+		return minioServerConfigIlmRead(ctx, d, meta)
+	}
+
+	configString := "ilm " + strings.Join(parts, " ")
+	restart, err := admin.SetConfigKV(ctx, configString)
+	if err != nil {
+		return NewResourceError("setting ilm configuration", "ilm", err)
+	}
+
+	d.SetId("ilm")
+	_ = d.Set("restart_required", restart)
+	tflog.Debug(ctx, fmt.Sprintf("Set ilm config (restart_required=%v)", restart))
+
+	return minioServerConfigIlmRead(ctx, d, meta)
+}
+
+func minioServerConfigIlmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+
+	cfgMap, err := getServerConfigSubsys(ctx, admin, "ilm")
+	if err != nil {
+		return NewResourceError("reading ilm configuration", "ilm", err)
+	}
+
+	d.SetId("ilm")
+	if err := setServerConfigFields(d, cfgMap, serverConfigIlmFields); err != nil {
+		return NewResourceError("setting ilm configuration", "ilm", err)
+	}
+
+	return nil
+}
+
+func minioServerConfigIlmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	admin := meta.(*S3MinioClient).S3Admin
+	_, err := admin.DelConfigKV(ctx, "ilm")
+	if err != nil {
+		errMsg := strings.ToLower(err.Error())
+		if !strings.Contains(errMsg, "not found") {
+			return NewResourceError("resetting ilm configuration", "ilm", err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -204,3 +204,204 @@ resource "minio_server_config_etcd" "test" {
 		},
 	})
 }
+
+func TestAccMinioServerConfigCompression_basic(t *testing.T) {
+	resourceName := "minio_server_config_compression.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "minio_server_config_compression" "test" {
+  enable     = true
+  extensions = [".txt", ".log"]
+  mime_types = ["text/*", "application/json"]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "extensions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "extensions.1", ".log"),
+					resource.TestCheckResourceAttr(resourceName, "mime_types.0", "text/*"),
+				),
+			},
+			{
+				Config: `
+resource "minio_server_config_compression" "test" {
+  enable           = false
+  allow_encryption = false
+  extensions       = [".csv"]
+  mime_types       = ["text/*", "application/json"]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "allow_encryption", "false"),
+					resource.TestCheckResourceAttr(resourceName, "extensions.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restart_required"},
+			},
+		},
+	})
+}
+
+func TestAccMinioServerConfigBrowser_basic(t *testing.T) {
+	resourceName := "minio_server_config_browser.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "minio_server_config_browser" "test" {
+  csp_policy              = "default-src 'self' 'unsafe-eval' 'unsafe-inline';"
+  hsts_seconds            = "31536000"
+  hsts_include_subdomains = true
+  referrer_policy         = "no-referrer"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "csp_policy", "default-src 'self' 'unsafe-eval' 'unsafe-inline';"),
+					resource.TestCheckResourceAttr(resourceName, "hsts_seconds", "31536000"),
+					resource.TestCheckResourceAttr(resourceName, "hsts_include_subdomains", "true"),
+					resource.TestCheckResourceAttr(resourceName, "referrer_policy", "no-referrer"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restart_required"},
+			},
+		},
+	})
+}
+
+func TestAccMinioServerConfigCallhome_basic(t *testing.T) {
+	resourceName := "minio_server_config_callhome.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "minio_server_config_callhome" "test" {
+  frequency = "48h"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "frequency", "48h"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restart_required"},
+			},
+		},
+	})
+}
+
+func TestAccMinioServerConfigDrive_basic(t *testing.T) {
+	resourceName := "minio_server_config_drive.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "minio_server_config_drive" "test" {
+  max_timeout = "45s"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_timeout", "45s"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restart_required"},
+			},
+		},
+	})
+}
+
+func TestAccMinioServerConfigIlm_basic(t *testing.T) {
+	resourceName := "minio_server_config_ilm.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "minio_server_config_ilm" "test" {
+  transition_workers = "50"
+  expiration_workers = "50"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transition_workers", "50"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_workers", "50"),
+				),
+			},
+			{
+				Config: `
+resource "minio_server_config_ilm" "test" {
+  transition_workers = "100"
+  expiration_workers = "25"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transition_workers", "100"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_workers", "25"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restart_required"},
+			},
+		},
+	})
+}
+
+func TestParseConfigParams_quotedValues(t *testing.T) {
+	got := parseConfigParams(`csp_policy="default-src 'self' 'unsafe-inline';" hsts_seconds=0 referrer_policy=strict-origin`)
+
+	want := map[string]string{
+		"csp_policy":      "default-src 'self' 'unsafe-inline';",
+		"hsts_seconds":    "0",
+		"referrer_policy": "strict-origin",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("parseConfigParams()[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("parseConfigParams() returned %d keys, want %d: %v", len(got), len(want), got)
+	}
+}
+
+func TestQuoteConfigValue(t *testing.T) {
+	for in, want := range map[string]string{
+		"30s":                "30s",
+		".txt,.log":          ".txt,.log",
+		"default-src 'self'": `"default-src 'self'"`,
+	} {
+		if got := quoteConfigValue(in); got != want {
+			t.Errorf("quoteConfigValue(%q) = %q, want %q", in, got, want)
+		}
+		if got := parseConfigParams("k=" + quoteConfigValue(in))["k"]; got != in {
+			t.Errorf("round trip of %q = %q", in, got)
+		}
+	}
+}
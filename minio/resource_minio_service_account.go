@@ -21,8 +21,9 @@ func resourceMinioServiceAccount() *schema.Resource {
 		UpdateContext: minioUpdateServiceAccount,
 		DeleteContext: minioDeleteServiceAccount,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: schema.ImportStatePassthroughWithIdentity("access_key"),
 		},
+		Identity: stringIdentity("access_key", "Access key of the service account."),
 
 		Schema: map[string]*schema.Schema{
 			"target_user": {
@@ -317,6 +318,10 @@ func minioReadServiceAccount(ctx context.Context, d *schema.ResourceData, meta i
 		_ = d.Set("access_key", d.Id())
 	}
 
+	if err := setStringIdentity(d, "access_key"); err != nil {
+		return NewResourceError("reading service account failed", d.Id(), err)
+	}
+
 	if err := d.Set("status", output.AccountStatus); err != nil {
 		return NewResourceError("reading service account failed", d.Id(), err)
 	}
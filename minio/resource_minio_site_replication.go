@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -76,9 +77,16 @@ func resourceMinioSiteReplication() *schema.Resource {
 		ReadContext:   minioReadSiteReplication,
 		UpdateContext: minioUpdateSiteReplication,
 		DeleteContext: minioDeleteSiteReplication,
+		CustomizeDiff: customizeDiffSiteReplication,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -148,6 +156,22 @@ func resourceMinioSiteReplication() *schema.Resource {
 	}
 }
 
+// customizeDiffSiteReplication probes the endpoints of the sites when
+// validate_against_server is set.
+func customizeDiffSiteReplication(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !validateAgainstServer(meta) || !d.HasChange("site") {
+		return nil
+	}
+
+	var endpoints []string
+	for _, site := range expandSites(d.Get("site").([]interface{})) {
+		if site.Endpoint != "" {
+			endpoints = append(endpoints, endpointBaseURL(site.Endpoint, true))
+		}
+	}
+	return checkEndpointsReachable(ctx, meta, endpoints)
+}
+
 func minioCreateSiteReplication(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*S3MinioClient).S3Admin
 	name := d.Get("name").(string)
@@ -164,7 +188,7 @@ func minioCreateSiteReplication(ctx context.Context, d *schema.ResourceData, met
 	}
 	status, err := client.SiteReplicationAdd(ctx, sites, opts)
 	if err != nil {
-		return NewResourceError("error creating site replication", name, err)
+		return NewResourceError("error creating site replication", name, timeoutHint(ctx, err, schema.TimeoutCreate))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Site replication created: %+v", status))
@@ -225,7 +249,7 @@ func minioUpdateSiteReplication(ctx context.Context, d *schema.ResourceData, met
 			}
 			_, err := client.SiteReplicationEdit(ctx, peer, editOpts)
 			if err != nil {
-				return NewResourceError("updating ILM expiry replication", d.Id(), err)
+				return NewResourceError("updating ILM expiry replication", d.Id(), timeoutHint(ctx, err, schema.TimeoutUpdate))
 			}
 		}
 	}
@@ -246,7 +270,7 @@ func minioUpdateSiteReplication(ctx context.Context, d *schema.ResourceData, met
 				SiteNames: diff.toRemove,
 			})
 			if err != nil {
-				return NewResourceError("error removing sites from replication", d.Id(), err)
+				return NewResourceError("error removing sites from replication", d.Id(), timeoutHint(ctx, err, schema.TimeoutUpdate))
 			}
 		}
 
@@ -261,7 +285,7 @@ func minioUpdateSiteReplication(ctx context.Context, d *schema.ResourceData, met
 				}()))
 			_, err := client.SiteReplicationAdd(ctx, diff.toAdd, madmin.SRAddOptions{})
 			if err != nil {
-				return NewResourceError("error adding sites to replication", d.Id(), err)
+				return NewResourceError("error adding sites to replication", d.Id(), timeoutHint(ctx, err, schema.TimeoutUpdate))
 			}
 		}
 	}
@@ -289,7 +313,7 @@ func minioDeleteSiteReplication(ctx context.Context, d *schema.ResourceData, met
 			tflog.Info(ctx, fmt.Sprintf("Site replication already removed or not configured: %v", err))
 			return nil
 		}
-		return NewResourceError("error deleting site replication", d.Id(), err)
+		return NewResourceError("error deleting site replication", d.Id(), timeoutHint(ctx, err, schema.TimeoutDelete))
 	}
 
 	return nil
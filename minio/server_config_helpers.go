@@ -0,0 +1,143 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/madmin-go/v4"
+)
+
+var (
+	serverConfigExtensionRegexp = regexp.MustCompile(`^\.[A-Za-z0-9_+-]+$`)
+	serverConfigMimeTypeRegexp  = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*/(\*|[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*)$`)
+)
+
+// serverConfigFields describes how the attributes of a typed server config
+// resource map onto the keys of a MinIO config subsystem. Attribute names
+// match the subsystem keys.
+type serverConfigFields struct {
+	values []string // plain string values
+	bools  []string // on/off values exposed as booleans
+	lists  []string // comma-separated values exposed as lists of strings
+}
+
+// serverConfigParams builds the key=value pairs for SetConfigKV from the
+// configured attributes. Booleans are only sent when present in the
+// configuration so that an explicit false is not confused with unset.
+func serverConfigParams(d *schema.ResourceData, fields serverConfigFields) []string {
+	var parts []string
+
+	for _, f := range fields.values {
+		if v, ok := d.GetOk(f); ok {
+			parts = append(parts, fmt.Sprintf("%s=%s", f, quoteConfigValue(v.(string))))
+		}
+	}
+
+	rawConfig := d.GetRawConfig()
+	for _, f := range fields.bools {
+		if !rawConfig.IsNull() {
+			if attr := rawConfig.GetAttr(f); !attr.IsKnown() || attr.IsNull() {
+				continue
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", f, serverConfigOnOff(d.Get(f).(bool))))
+	}
+
+	for _, f := range fields.lists {
+		if v, ok := d.GetOk(f); ok {
+			values := make([]string, 0, len(v.([]interface{})))
+			for _, item := range v.([]interface{}) {
+				values = append(values, item.(string))
+			}
+			parts = append(parts, fmt.Sprintf("%s=%s", f, quoteConfigValue(strings.Join(values, ","))))
+		}
+	}
+
+	return parts
+}
+
+// setServerConfigFields copies the values parsed from a subsystem's config
+// into the resource, so that changes made outside Terraform show up as drift.
+func setServerConfigFields(d *schema.ResourceData, cfgMap map[string]string, fields serverConfigFields) error {
+	for _, f := range fields.values {
+		if v, ok := cfgMap[f]; ok {
+			if err := d.Set(f, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, f := range fields.bools {
+		if v, ok := cfgMap[f]; ok {
+			if err := d.Set(f, v == "on"); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, f := range fields.lists {
+		if v, ok := cfgMap[f]; ok {
+			var values []string
+			for _, item := range strings.Split(v, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					values = append(values, item)
+				}
+			}
+			if err := d.Set(f, values); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getServerConfigSubsys reads a config subsystem and returns its key/value pairs.
+func getServerConfigSubsys(ctx context.Context, admin *madmin.AdminClient, subsys string) (map[string]string, error) {
+	configData, err := admin.GetConfigKV(ctx, subsys)
+	if err != nil {
+		return nil, err
+	}
+
+	configStr := strings.TrimSpace(string(configData))
+	var valueStr string
+	if strings.HasPrefix(configStr, subsys+" ") {
+		valueStr = strings.TrimSpace(strings.TrimPrefix(configStr, subsys+" "))
+	}
+
+	return parseConfigParams(valueStr), nil
+}
+
+func serverConfigOnOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+func validateServerConfigDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as \"30s\" or \"24h\", got %q", k, value))
+		return
+	}
+	if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got %q", k, value))
+	}
+	return
+}
+
+func validateServerConfigPositiveInt(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive integer, got %q", k, value))
+	}
+	return
+}
@@ -0,0 +1,139 @@
+package minio
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/minio/pkg/v3/policy"
+)
+
+// The provider flag validate_against_server makes the CustomizeDiff functions
+// check planned changes against the live server with read-only calls, so that
+// a change the server would reject fails at plan time rather than halfway
+// through an apply. Without the flag, only the checks that always ran do, and
+// they are skipped with a warning when the server cannot be asked.
+
+// validateAgainstServer reports whether the validate_against_server provider
+// flag is set.
+func validateAgainstServer(meta interface{}) bool {
+	client, ok := meta.(*S3MinioClient)
+	return ok && client != nil && client.ValidateAgainstServer
+}
+
+// serverValidationUnavailable handles the failure of the read-only call a
+// plan-time validation depends on: an error with validate_against_server, a
+// logged warning otherwise.
+func serverValidationUnavailable(ctx context.Context, meta interface{}, what string, err error) error {
+	if validateAgainstServer(meta) {
+		return fmt.Errorf("could not validate %s against the server: %w", what, err)
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Could not validate %s against the server, skipping plan-time validation: %v", what, err))
+	return nil
+}
+
+// validateIAMPolicyDocument parses a policy document the way MinIO does when
+// the policy is added.
+func validateIAMPolicyDocument(document string) error {
+	_, err := policy.ParseConfig(strings.NewReader(document))
+	return err
+}
+
+// customizeDiffIAMPolicyDocument rejects a planned "policy" document that
+// MinIO would not accept, when validate_against_server is set.
+func customizeDiffIAMPolicyDocument(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !validateAgainstServer(meta) || !d.HasChange("policy") || !d.NewValueKnown("policy") {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Validating the policy document against the server policy parser")
+	if err := validateIAMPolicyDocument(d.Get("policy").(string)); err != nil {
+		return fmt.Errorf("policy would be rejected by the server: %w", err)
+	}
+	return nil
+}
+
+// endpointBaseURL returns the base URL of an endpoint given either as a URL or
+// as a host with an optional port.
+func endpointBaseURL(endpoint string, secure bool) string {
+	if strings.Contains(endpoint, "://") {
+		return strings.TrimSuffix(endpoint, "/")
+	}
+	if secure {
+		return "https://" + endpoint
+	}
+	return "http://" + endpoint
+}
+
+// checkEndpointReachable sends a liveness probe to the endpoint, over the
+// provider's transport so that its CA, client certificate and minio_insecure
+// settings apply. Any HTTP response counts, since non-MinIO endpoints do not
+// implement the probe; only a failure to connect is an error. A certificate
+// that cannot be verified from here is only logged, as the endpoint answered
+// and it is the server, with its own trust store, that connects to it.
+func checkEndpointReachable(ctx context.Context, meta interface{}, baseURL string) error {
+	client := &http.Client{Timeout: 10 * time.Second}
+	if m, ok := meta.(*S3MinioClient); ok {
+		if m.RequestTimeoutSeconds > 0 {
+			client.Timeout = time.Duration(m.RequestTimeoutSeconds) * time.Second
+		}
+		if m.HTTPTransport != nil {
+			client.Transport = m.HTTPTransport
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/minio/health/live", nil)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %q: %w", baseURL, err)
+	}
+	resp, err := client.Do(req)
+	if isTLSVerificationError(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Endpoint %s is reachable but its TLS certificate could not be verified with the provider's TLS settings: %v", baseURL, err))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("endpoint %s is not reachable: %w", baseURL, err)
+	}
+	_ = resp.Body.Close()
+
+	tflog.Debug(ctx, fmt.Sprintf("Endpoint %s answered with status %d", baseURL, resp.StatusCode))
+	return nil
+}
+
+// isTLSVerificationError reports whether err is the failure to verify the
+// certificate presented by a server.
+func isTLSVerificationError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var (
+		verificationErr *tls.CertificateVerificationError
+		unknownAuthErr  x509.UnknownAuthorityError
+		hostnameErr     x509.HostnameError
+		invalidErr      x509.CertificateInvalidError
+	)
+	return errors.As(err, &verificationErr) || errors.As(err, &unknownAuthErr) ||
+		errors.As(err, &hostnameErr) || errors.As(err, &invalidErr)
+}
+
+// checkEndpointsReachable probes each distinct endpoint once.
+func checkEndpointsReachable(ctx context.Context, meta interface{}, baseURLs []string) error {
+	seen := make(map[string]bool, len(baseURLs))
+	for _, baseURL := range baseURLs {
+		if seen[baseURL] {
+			continue
+		}
+		seen[baseURL] = true
+		if err := checkEndpointReachable(ctx, meta, baseURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
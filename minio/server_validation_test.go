@@ -0,0 +1,144 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServerValidationUnavailable(t *testing.T) {
+	ctx := context.Background()
+	errServer := context.DeadlineExceeded
+
+	if err := serverValidationUnavailable(ctx, &S3MinioClient{}, "the notify_webhook config", errServer); err != nil {
+		t.Errorf("without validate_against_server, error = %v, want nil", err)
+	}
+	if err := serverValidationUnavailable(ctx, nil, "the notify_webhook config", errServer); err != nil {
+		t.Errorf("without a client, error = %v, want nil", err)
+	}
+
+	err := serverValidationUnavailable(ctx, &S3MinioClient{ValidateAgainstServer: true}, "the notify_webhook config", errServer)
+	if err == nil || !strings.Contains(err.Error(), "could not validate the notify_webhook config") {
+		t.Errorf("with validate_against_server, error = %v", err)
+	}
+}
+
+func TestValidateIAMPolicyDocument(t *testing.T) {
+	cases := []struct {
+		name     string
+		document string
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+		},
+		{
+			name:     "unknown action",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:FlyAway"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "unsupported version",
+			document: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid effect",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Maybe","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := validateIAMPolicyDocument(tc.document); (err != nil) != tc.wantErr {
+				t.Errorf("validateIAMPolicyDocument() error = %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestEndpointBaseURL(t *testing.T) {
+	cases := []struct {
+		endpoint string
+		secure   bool
+		want     string
+	}{
+		{endpoint: "minio.example.com:9000", secure: true, want: "https://minio.example.com:9000"},
+		{endpoint: "10.0.0.1:9000", secure: false, want: "http://10.0.0.1:9000"},
+		{endpoint: "http://minio.example.com:9000/", secure: true, want: "http://minio.example.com:9000"},
+		{endpoint: "https://s3.amazonaws.com", secure: false, want: "https://s3.amazonaws.com"},
+	}
+
+	for _, tc := range cases {
+		if got := endpointBaseURL(tc.endpoint, tc.secure); got != tc.want {
+			t.Errorf("endpointBaseURL(%q, %t) = %q, want %q", tc.endpoint, tc.secure, got, tc.want)
+		}
+	}
+}
+
+func TestCheckEndpointsReachable(t *testing.T) {
+	ctx := context.Background()
+	meta := &S3MinioClient{ValidateAgainstServer: true, RequestTimeoutSeconds: 5}
+
+	probes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes++
+		// Non-MinIO endpoints answer the probe with an error status.
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	if err := checkEndpointsReachable(ctx, meta, []string{srv.URL, srv.URL}); err != nil {
+		t.Errorf("reachable endpoint: error = %v", err)
+	}
+	if probes != 1 {
+		t.Errorf("endpoint probed %d times, want once", probes)
+	}
+
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	if err := checkEndpointsReachable(ctx, meta, []string{srv.URL, closed.URL}); err == nil || !strings.Contains(err.Error(), "is not reachable") {
+		t.Errorf("unreachable endpoint: error = %v", err)
+	}
+}
+
+func TestCheckEndpointReachableTLS(t *testing.T) {
+	ctx := context.Background()
+
+	probes := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes++
+	}))
+	defer srv.Close()
+
+	// The test server's certificate is signed by its own CA, which only the
+	// transport of srv.Client() trusts.
+	meta := &S3MinioClient{ValidateAgainstServer: true, HTTPTransport: srv.Client().Transport.(*http.Transport)}
+	if err := checkEndpointReachable(ctx, meta, srv.URL); err != nil {
+		t.Errorf("with the provider transport: error = %v", err)
+	}
+	if probes != 1 {
+		t.Errorf("endpoint probed %d times over the provider transport, want once", probes)
+	}
+
+	meta = &S3MinioClient{ValidateAgainstServer: true}
+	if err := checkEndpointReachable(ctx, meta, srv.URL); err != nil {
+		t.Errorf("with an untrusted certificate: error = %v, want the endpoint reported as reachable", err)
+	}
+
+	resp, err := http.Get(srv.URL)
+	if err == nil {
+		_ = resp.Body.Close()
+	}
+	if !isTLSVerificationError(err) {
+		t.Errorf("isTLSVerificationError(%v) = false", err)
+	}
+	if isTLSVerificationError(errors.New("connection refused")) {
+		t.Error("isTLSVerificationError(connection refused) = true")
+	}
+}
@@ -20,8 +20,9 @@ func shouldSkipBucketTagging(cfg *S3MinioBucket) bool {
 }
 
 // preserveBucketTagsState ensures Terraform state retains the last known set of
-// tags even when we skip remote API calls.
-func preserveBucketTagsState(d *schema.ResourceData) {
+// tags even when we skip remote API calls. tags_all is derived from them and the
+// provider default tags.
+func preserveBucketTagsState(d *schema.ResourceData, meta interface{}) {
 	if d == nil {
 		return
 	}
@@ -46,6 +47,8 @@ func preserveBucketTagsState(d *schema.ResourceData) {
 	} else {
 		_ = d.Set("tags", map[string]string{})
 	}
+
+	_ = d.Set("tags_all", resourceTagsAll(d, meta))
 }
 
 // IsS3TaggingNotImplemented attempts to detect when bucket tagging operations
@@ -0,0 +1,97 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// timeoutHint points at the resource's timeouts block when err was caused by
+// the deadline the SDK derives from it. operation is the timeouts attribute,
+// e.g. schema.TimeoutDelete.
+func timeoutHint(ctx context.Context, err error, operation string) error {
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	return fmt.Errorf("%w%s", err, timeoutHintSuffix(operation))
+}
+
+// timeoutHintDiags is timeoutHint for helpers that already return
+// diagnostics: the hint is appended to the detail of their errors.
+func timeoutHintDiags(ctx context.Context, diags diag.Diagnostics, operation string) diag.Diagnostics {
+	if !diags.HasError() || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return diags
+	}
+	for i := range diags {
+		if diags[i].Severity == diag.Error {
+			diags[i].Detail += timeoutHintSuffix(operation)
+		}
+	}
+	return diags
+}
+
+func timeoutHintSuffix(operation string) string {
+	return fmt.Sprintf(" (the %s timeout was reached; raise it in the timeouts block to wait longer)", operation)
+}
+
+// remainingTimeout returns the time left before the context deadline, for the
+// helpers that take a timeout rather than a context deadline, e.g.
+// retry.RetryContext.
+func remainingTimeout(ctx context.Context, fallback time.Duration) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fallback
+	}
+	return time.Until(deadline)
+}
+
+// operationFailedError marks the errors that end a wait for a long-running
+// server operation because the operation failed or was stopped.
+type operationFailedError struct {
+	err error
+}
+
+func (e operationFailedError) Error() string { return e.err.Error() }
+func (e operationFailedError) Unwrap() error { return e.err }
+
+// operationFailed ends a retry.RetryContext wait with err as a failure of the
+// awaited operation.
+func operationFailed(err error) *retry.RetryError {
+	return retry.NonRetryableError(operationFailedError{err: err})
+}
+
+// waitTimedOut reports whether a wait ended without the awaited operation
+// failing, i.e. because it ran out of time. On timeout, retry.RetryContext
+// returns the last retryable error rather than a timeout error.
+func waitTimedOut(err error) bool {
+	var failed operationFailedError
+	return err != nil && !errors.As(err, &failed)
+}
+
+// waitTimeoutWarning handles a Create that ran out of time waiting for a
+// long-running server operation. The operation keeps running, so the resource
+// is kept in state with a warning: an error would taint it, and replacing it
+// would cancel the operation and start it over. The state is read with a fresh
+// context, since the create context has expired, and read errors are reported
+// as warnings too; the next refresh catches up.
+func waitTimeoutWarning(ctx context.Context, d *schema.ResourceData, meta interface{}, operation string, err error, read schema.ReadContextFunc) diag.Diagnostics {
+	diags := diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Timed out waiting for the %s", operation),
+		Detail: fmt.Sprintf("The %s is still running on the server and %s was saved to state; refresh it to follow the progress. "+
+			"Raise the create timeout in the timeouts block to wait longer. Last status: %v", operation, d.Id(), err),
+	}}
+
+	readCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+	for _, readDiag := range read(readCtx, d, meta) {
+		readDiag.Severity = diag.Warning
+		diags = append(diags, readDiag)
+	}
+	return diags
+}
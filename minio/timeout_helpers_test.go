@@ -0,0 +1,112 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTimeoutHint(t *testing.T) {
+	errRemote := errors.New("connection reset")
+
+	if err := timeoutHint(context.Background(), errRemote, schema.TimeoutCreate); err != errRemote {
+		t.Errorf("timeoutHint without a deadline = %v, want the error unchanged", err)
+	}
+	if err := timeoutHint(context.Background(), nil, schema.TimeoutCreate); err != nil {
+		t.Errorf("timeoutHint(nil) = %v", err)
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	err := timeoutHint(ctx, errRemote, schema.TimeoutDelete)
+	if !errors.Is(err, errRemote) {
+		t.Errorf("timeoutHint(%v) = %v, want it to wrap the error", errRemote, err)
+	}
+	if !strings.Contains(err.Error(), "the delete timeout was reached") {
+		t.Errorf("timeoutHint(%v) = %q, want it to name the delete timeout", errRemote, err)
+	}
+
+	diags := timeoutHintDiags(ctx, diag.Diagnostics{
+		{Severity: diag.Warning, Summary: "slow", Detail: "still listing"},
+		{Severity: diag.Error, Summary: "force destroy", Detail: "context deadline exceeded"},
+	}, schema.TimeoutDelete)
+	if diags[0].Detail != "still listing" {
+		t.Errorf("warning detail changed to %q", diags[0].Detail)
+	}
+	if !strings.HasPrefix(diags[1].Detail, "context deadline exceeded (the delete timeout was reached") {
+		t.Errorf("error detail = %q, want the timeout hint appended", diags[1].Detail)
+	}
+}
+
+func TestRemainingTimeout(t *testing.T) {
+	if got := remainingTimeout(context.Background(), time.Minute); got != time.Minute {
+		t.Errorf("remainingTimeout without a deadline = %s, want the fallback", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	if got := remainingTimeout(ctx, time.Minute); got <= 9*time.Minute || got > 10*time.Minute {
+		t.Errorf("remainingTimeout = %s, want about 10m", got)
+	}
+}
+
+func TestWaitTimedOut(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := retry.RetryContext(ctx, remainingTimeout(ctx, time.Minute), func() *retry.RetryError {
+		return retry.RetryableError(errors.New("rebalance is still running"))
+	})
+	if !waitTimedOut(err) {
+		t.Errorf("waitTimedOut(%v) = false for a wait that ran out of time", err)
+	}
+
+	err = retry.RetryContext(context.Background(), time.Minute, func() *retry.RetryError {
+		return operationFailed(errors.New("rebalance of pool 1 is failed"))
+	})
+	if waitTimedOut(err) {
+		t.Errorf("waitTimedOut(%v) = true for a failed operation", err)
+	}
+	if err.Error() != "rebalance of pool 1 is failed" {
+		t.Errorf("failed wait error = %q, want the operation error unchanged", err)
+	}
+	if waitTimedOut(nil) {
+		t.Error("waitTimedOut(nil) = true")
+	}
+}
+
+func TestWaitTimeoutWarning(t *testing.T) {
+	res := &schema.Resource{Schema: map[string]*schema.Schema{
+		"status": {Type: schema.TypeString, Computed: true},
+	}}
+	d := res.TestResourceData()
+	d.SetId("pool-1")
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	read := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if ctx.Err() != nil {
+			t.Errorf("read called with an expired context: %v", ctx.Err())
+		}
+		_ = d.Set("status", "decommissioning")
+		return diag.Errorf("reading pool status: connection reset")
+	}
+
+	diags := waitTimeoutWarning(ctx, d, nil, "decommission of pool index 1", context.DeadlineExceeded, read)
+	if diags.HasError() {
+		t.Fatalf("waitTimeoutWarning() returned errors, which would taint the resource: %v", diags)
+	}
+	if len(diags) != 2 || !strings.Contains(diags[0].Summary, "Timed out waiting for the decommission of pool index 1") {
+		t.Errorf("diags = %v, want the timeout warning and the read warning", diags)
+	}
+	if d.Id() != "pool-1" || d.Get("status") != "decommissioning" {
+		t.Errorf("state = %q/%q, want the resource kept and read", d.Id(), d.Get("status"))
+	}
+}